@@ -14,9 +14,14 @@
 package main
 
 import (
+	"flag"
+
 	scaleEvaluator "open-match.dev/open-match/examples/scale/evaluator"
+	"open-match.dev/open-match/examples/scale/scenarios"
 )
 
 func main() {
+	scenarios.RegisterFlags(flag.CommandLine)
+	flag.Parse()
 	scaleEvaluator.Run()
 }
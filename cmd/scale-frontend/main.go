@@ -15,10 +15,15 @@
 package main
 
 import (
+	"flag"
+
 	"open-match.dev/open-match/examples/scale/frontend"
+	"open-match.dev/open-match/examples/scale/scenarios"
 	"open-match.dev/open-match/internal/appmain"
 )
 
 func main() {
+	scenarios.RegisterFlags(flag.CommandLine)
+	flag.Parse()
 	appmain.RunApplication("scale", frontend.BindService)
 }
@@ -15,9 +15,14 @@
 package main
 
 import (
+	"flag"
+
 	scaleMmf "open-match.dev/open-match/examples/scale/mmf"
+	"open-match.dev/open-match/examples/scale/scenarios"
 )
 
 func main() {
+	scenarios.RegisterFlags(flag.CommandLine)
+	flag.Parse()
 	scaleMmf.Run()
 }
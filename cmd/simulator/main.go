@@ -0,0 +1,157 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package main is the main for the simulator, which runs a matchmaking
+// scenario against Open Match in-process on a simulated clock, and reports
+// the wait times, the match quality and the throughput.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"open-match.dev/open-match/examples/scale/scenarios"
+	"open-match.dev/open-match/internal/simulator"
+)
+
+var (
+	scenarioFlag   = flag.String("scenario", "firstmatch", "Game scenario to simulate, one of "+strings.Join(scenarios.Names(), ", ")+".")
+	durationFlag   = flag.Duration("duration", time.Hour, "Simulated time during which tickets arrive.")
+	cycleFlag      = flag.Duration("cycle", time.Second, "Simulated time between two fetch cycles.")
+	arrivalFlag    = flag.String("arrival", "poisson", "Distribution of the ticket arrivals: poisson, uniform or trace.")
+	rateFlag       = flag.Float64("rate", 10, "Tickets arriving per simulated second, for the poisson and uniform arrivals.")
+	traceFlag      = flag.String("trace", "", "Recorded trace of the arrivals, one JSON object per line with the offset in seconds and optionally the ticket.")
+	seedFlag       = flag.Int64("seed", 0, "Seed of the random arrivals, the current time if 0.")
+	backfillsFlag  = flag.Int("backfills", 0, "Number of backfills created at the start.")
+	doubleArgsFlag = flag.String("double_args", "", "Comma separated double args whose spread within the matches is reported.")
+	formatFlag     = flag.String("format", "json", "Format of the report: json or csv.")
+	outFlag        = flag.String("out", "", "File to write the report to, the standard output if empty.")
+	configFlag     = configValues{}
+)
+
+func init() {
+	flag.Var(configFlag, "config", "Open Match configuration override as key=value, for example pendingReleaseTimeout=1m. Can be repeated.")
+}
+
+// configValues collects the repeated -config flags.
+type configValues map[string]interface{}
+
+func (c configValues) String() string {
+	return fmt.Sprint(map[string]interface{}(c))
+}
+
+func (c configValues) Set(v string) error {
+	kv := strings.SplitN(v, "=", 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return fmt.Errorf("%q must be key=value", v)
+	}
+	c[kv[0]] = kv[1]
+	return nil
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run simulates the scenario of the flags and writes the report.
+func run() (err error) {
+	newScenario, ok := scenarios.GameScenarios[*scenarioFlag]
+	if !ok {
+		return fmt.Errorf("unknown scenario %q, must be one of %s", *scenarioFlag, strings.Join(scenarios.Names(), ", "))
+	}
+
+	arrivals, closeArrivals, err := flagToArrivals()
+	if err != nil {
+		return err
+	}
+	defer closeArrivals()
+
+	var doubleArgs []string
+	if *doubleArgsFlag != "" {
+		doubleArgs = strings.Split(*doubleArgsFlag, ",")
+	}
+
+	report, err := simulator.Run(context.Background(), &simulator.Params{
+		Name:          *scenarioFlag,
+		Scenario:      newScenario(),
+		Arrivals:      arrivals,
+		Duration:      *durationFlag,
+		CycleInterval: *cycleFlag,
+		Backfills:     *backfillsFlag,
+		DoubleArgs:    doubleArgs,
+		Config:        configFlag,
+	})
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *outFlag != "" {
+		f, err := os.Create(*outFlag)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		w = f
+	}
+
+	switch *formatFlag {
+	case "json":
+		return report.WriteJSON(w)
+	case "csv":
+		return report.WriteCSV(w)
+	default:
+		return fmt.Errorf("unknown format %q, must be json or csv", *formatFlag)
+	}
+}
+
+func flagToArrivals() (simulator.Arrivals, func(), error) {
+	switch *arrivalFlag {
+	case "poisson", "uniform":
+		if *rateFlag <= 0 {
+			return nil, nil, fmt.Errorf("the arrival rate must be positive, got %v", *rateFlag)
+		}
+		if *arrivalFlag == "uniform" {
+			return simulator.UniformArrivals(*rateFlag), func() {}, nil
+		}
+		seed := *seedFlag
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		return simulator.PoissonArrivals(*rateFlag, rand.New(rand.NewSource(seed))), func() {}, nil
+	case "trace":
+		f, err := os.Open(*traceFlag)
+		if err != nil {
+			return nil, nil, err
+		}
+		return simulator.TraceArrivals(f), func() { f.Close() }, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown arrival distribution %q, must be poisson, uniform or trace", *arrivalFlag)
+	}
+}
@@ -16,6 +16,7 @@ package backend
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"io"
 	"math/rand"
@@ -23,6 +24,7 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/stats"
 	"go.opencensus.io/trace"
 	"open-match.dev/open-match/examples/scale/scenarios"
 	"open-match.dev/open-match/internal/appmain"
@@ -38,7 +40,8 @@ var (
 		"component": "scale.backend",
 	})
 
-	activeScenario = scenarios.ActiveScenario
+	activeScenario *scenarios.Scenario
+	summary        *scenarios.Summary
 
 	mIterations            = telemetry.Counter("scale_backend_iterations", "fetch match iterations")
 	mFetchMatchCalls       = telemetry.Counter("scale_backend_fetch_match_calls", "fetch match calls")
@@ -55,6 +58,14 @@ var (
 // Run triggers execution of functions that continuously fetch, assign and
 // delete matches.
 func BindService(p *appmain.Params, b *appmain.Bindings) error {
+	s, err := scenarios.FromConfig(p.Config(), flag.CommandLine)
+	if err != nil {
+		return err
+	}
+	activeScenario = s
+	summary = scenarios.NewSummary("scale-backend", s)
+	b.AddCloser(summary.Write)
+
 	go run(p.Config())
 	return nil
 }
@@ -103,9 +114,24 @@ func run(cfg config.View) {
 		}
 	}
 
+	var done <-chan time.Time
+	if activeScenario.Duration > 0 {
+		done = time.After(activeScenario.Duration)
+	}
+
 	// Don't go faster than this, as it likely means that FetchMatches is throwing
 	// errors, and will continue doing so if queried very quickly.
-	for range time.Tick(time.Millisecond * 250) {
+	ticker := time.NewTicker(time.Millisecond * 250)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			logger.Infof("Run of %s finished after %s", activeScenario.Name, activeScenario.Duration)
+			summary.Write()
+			return
+		case <-ticker.C:
+		}
+
 		// Keep pulling matches from Open Match backend
 		profiles := activeScenario.Profiles()
 		var wg sync.WaitGroup
@@ -120,7 +146,7 @@ func run(cfg config.View) {
 
 		// Wait for all profiles to complete before proceeding.
 		wg.Wait()
-		telemetry.RecordUnitMeasurement(context.Background(), mIterations)
+		record(context.Background(), mIterations)
 	}
 }
 
@@ -137,10 +163,10 @@ func runFetchMatches(be pb.BackendServiceClient, p *pb.MatchProfile, matchesToAs
 		Profile: p,
 	}
 
-	telemetry.RecordUnitMeasurement(ctx, mFetchMatchCalls)
+	record(ctx, mFetchMatchCalls)
 	stream, err := be.FetchMatches(ctx, req)
 	if err != nil {
-		telemetry.RecordUnitMeasurement(ctx, mFetchMatchErrors)
+		record(ctx, mFetchMatchErrors)
 		logger.WithError(err).Error("failed to get available stream client")
 		return
 	}
@@ -149,18 +175,18 @@ func runFetchMatches(be pb.BackendServiceClient, p *pb.MatchProfile, matchesToAs
 		// Pull the Match
 		resp, err := stream.Recv()
 		if err == io.EOF {
-			telemetry.RecordUnitMeasurement(ctx, mFetchMatchSuccesses)
+			record(ctx, mFetchMatchSuccesses)
 			return
 		}
 
 		if err != nil {
-			telemetry.RecordUnitMeasurement(ctx, mFetchMatchErrors)
+			record(ctx, mFetchMatchErrors)
 			logger.WithError(err).Error("failed to get matches from stream client")
 			return
 		}
 
-		telemetry.RecordNUnitMeasurement(ctx, mSumTicketsReturned, int64(len(resp.GetMatch().Tickets)))
-		telemetry.RecordUnitMeasurement(ctx, mMatchesReturned)
+		recordN(ctx, mSumTicketsReturned, int64(len(resp.GetMatch().Tickets)))
+		record(ctx, mMatchesReturned)
 
 		if activeScenario.BackendAssignsTickets {
 			matchesToAssign <- resp.GetMatch()
@@ -182,9 +208,9 @@ func runDeleteBackfills(fe pb.FrontendServiceClient, backfillsToDelete <-chan *p
 		_, err := fe.DeleteBackfill(ctx, &pb.DeleteBackfillRequest{BackfillId: b.Id})
 		if err != nil {
 			logger.WithError(err).Errorf("failed to delete backfill: %s", b.Id)
-			telemetry.RecordUnitMeasurement(ctx, mBackfillDeletesFailed)
+			record(ctx, mBackfillDeletesFailed)
 		} else {
-			telemetry.RecordUnitMeasurement(ctx, mBackfillsDeleted)
+			record(ctx, mBackfillsDeleted)
 		}
 	}
 }
@@ -241,11 +267,22 @@ func runAssignments(be pb.BackendServiceClient, matchesToAssign <-chan *pb.Match
 			},
 		})
 		if err != nil {
-			telemetry.RecordUnitMeasurement(ctx, mMatchAssignsFailed)
+			record(ctx, mMatchAssignsFailed)
 			logger.WithError(err).Error("failed to assign tickets")
 			continue
 		}
 
-		telemetry.RecordUnitMeasurement(ctx, mMatchesAssigned)
+		record(ctx, mMatchesAssigned)
 	}
 }
+
+// record records a unit measurement of m, and counts it in the summary of the
+// run.
+func record(ctx context.Context, m *stats.Int64Measure) {
+	recordN(ctx, m, 1)
+}
+
+func recordN(ctx context.Context, m *stats.Int64Measure, n int64) {
+	telemetry.RecordNUnitMeasurement(ctx, m, n)
+	summary.Add(m.Name(), n)
+}
@@ -14,6 +14,7 @@
 package evaluator
 
 import (
+	"flag"
 	"fmt"
 	"net"
 
@@ -22,6 +23,7 @@ import (
 
 	"open-match.dev/open-match/pkg/pb"
 
+	"open-match.dev/open-match/internal/config"
 	utilTesting "open-match.dev/open-match/internal/util/testing"
 
 	"open-match.dev/open-match/examples/scale/scenarios"
@@ -36,7 +38,14 @@ var (
 
 // Run triggers execution of an evaluator.
 func Run() {
-	activeScenario := scenarios.ActiveScenario
+	cfg, err := config.Read()
+	if err != nil {
+		logger.WithError(err).Fatal("failed to read the configuration")
+	}
+	activeScenario, err := scenarios.FromConfig(cfg, flag.CommandLine)
+	if err != nil {
+		logger.WithError(err).Fatal("invalid scale scenario")
+	}
 
 	server := grpc.NewServer(utilTesting.NewGRPCServerOptions(logger)...)
 	pb.RegisterEvaluatorServer(server, activeScenario.Evaluator)
@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"flag"
 	"math/rand"
 	"sync"
 	"time"
@@ -36,7 +37,8 @@ var (
 		"app":       "openmatch",
 		"component": "scale.frontend",
 	})
-	activeScenario = scenarios.ActiveScenario
+	activeScenario *scenarios.Scenario
+	summary        *scenarios.Summary
 
 	mTicketsCreated          = telemetry.Counter("scale_frontend_tickets_created", "tickets created")
 	mTicketCreationsFailed   = telemetry.Counter("scale_frontend_ticket_creations_failed", "tickets created")
@@ -57,6 +59,14 @@ type ticketToWatch struct {
 // Run triggers execution of the scale frontend component that creates
 // tickets at scale in Open Match.
 func BindService(p *appmain.Params, b *appmain.Bindings) error {
+	s, err := scenarios.FromConfig(p.Config(), flag.CommandLine)
+	if err != nil {
+		return err
+	}
+	activeScenario = s
+	summary = scenarios.NewSummary("scale-frontend", s)
+	b.AddCloser(summary.Write)
+
 	go run(p.Config())
 
 	return nil
@@ -79,10 +89,26 @@ func run(cfg config.View) {
 	ticketTotal := activeScenario.FrontendTotalTicketsToCreate
 	totalCreated := 0
 
-	for range time.Tick(time.Second) {
+	var done <-chan time.Time
+	if activeScenario.Duration > 0 {
+		done = time.After(activeScenario.Duration)
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			logger.Infof("Run of %s finished after %s", activeScenario.Name, activeScenario.Duration)
+			summary.Write()
+			return
+		case <-ticker.C:
+		}
+
 		for i := 0; i < ticketQPS; i++ {
 			if ticketTotal == -1 || totalCreated < ticketTotal {
 				go runner(fe)
+				totalCreated++
 			}
 		}
 	}
@@ -115,6 +141,7 @@ func runner(fe pb.FrontendServiceClient) {
 	} else {
 		ms := time.Since(createdAt).Nanoseconds() / 1e6
 		stats.Record(ctx, mTicketsTimeToAssignment.M(ms))
+		summary.Observe(mTicketsTimeToAssignment.Name(), float64(ms))
 	}
 
 	if activeScenario.FrontendDeletesTickets {
@@ -135,11 +162,11 @@ func createTicket(ctx context.Context, fe pb.FrontendServiceClient) (string, err
 
 	resp, err := fe.CreateTicket(ctx, req)
 	if err != nil {
-		telemetry.RecordUnitMeasurement(ctx, mTicketCreationsFailed)
+		record(ctx, mTicketCreationsFailed)
 		return "", err
 	}
 
-	telemetry.RecordUnitMeasurement(ctx, mTicketsCreated)
+	record(ctx, mTicketsCreated)
 	return resp.Id, nil
 }
 
@@ -185,21 +212,21 @@ func createBackfill(fe pb.FrontendServiceClient) error {
 
 	_, err := fe.CreateBackfill(ctx, &req)
 	if err != nil {
-		telemetry.RecordUnitMeasurement(ctx, mBackfillCreationsFailed)
+		record(ctx, mBackfillCreationsFailed)
 		logger.WithError(err).Error("failed to create backfill")
 		return err
 	}
 
-	telemetry.RecordUnitMeasurement(ctx, mBackfillsCreated)
+	record(ctx, mBackfillsCreated)
 	return nil
 }
 
 func deleteTicket(ctx context.Context, fe pb.FrontendServiceClient, ticketId string) error {
 	_, err := fe.DeleteTicket(ctx, &pb.DeleteTicketRequest{TicketId: ticketId})
 	if err != nil {
-		telemetry.RecordUnitMeasurement(ctx, mTicketDeletesFailed)
+		record(ctx, mTicketDeletesFailed)
 	} else {
-		telemetry.RecordUnitMeasurement(ctx, mTicketsDeleted)
+		record(ctx, mTicketsDeleted)
 	}
 
 	return err
@@ -239,3 +266,10 @@ func (g *stateGauge) stop() {
 		g.f = nil
 	}
 }
+
+// record records a unit measurement of m, and counts it in the summary of the
+// run.
+func record(ctx context.Context, m *stats.Int64Measure) {
+	telemetry.RecordUnitMeasurement(ctx, m)
+	summary.Add(m.Name(), 1)
+}
@@ -15,6 +15,7 @@
 package mmf
 
 import (
+	"flag"
 	"fmt"
 	"net"
 
@@ -23,6 +24,7 @@ import (
 
 	"open-match.dev/open-match/pkg/pb"
 
+	"open-match.dev/open-match/internal/config"
 	utilTesting "open-match.dev/open-match/internal/util/testing"
 
 	"open-match.dev/open-match/examples/scale/scenarios"
@@ -37,7 +39,14 @@ var (
 
 // Run triggers execution of a MMF.
 func Run() {
-	activeScenario := scenarios.ActiveScenario
+	cfg, err := config.Read()
+	if err != nil {
+		logger.WithError(err).Fatal("failed to read the configuration")
+	}
+	activeScenario, err := scenarios.FromConfig(cfg, flag.CommandLine)
+	if err != nil {
+		logger.WithError(err).Fatal("invalid scale scenario")
+	}
 
 	conn, err := grpc.Dial("open-match-query.open-match.svc.cluster.local:50503", utilTesting.NewGRPCDialOptions(logger)...)
 	if err != nil {
@@ -16,11 +16,10 @@ package scenarios
 
 import (
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
-	"open-match.dev/open-match/examples/scale/scenarios/backfill"
-	"open-match.dev/open-match/examples/scale/scenarios/firstmatch"
 	"open-match.dev/open-match/internal/util/testing"
 	"open-match.dev/open-match/pkg/matchfunction"
 	"open-match.dev/open-match/pkg/pb"
@@ -52,40 +51,16 @@ type GameScenario interface {
 	Evaluate(stream pb.Evaluator_EvaluateServer) error
 }
 
-// ActiveScenario sets the scenario with preset parameters that we want to use for current Open Match benchmark run.
-var ActiveScenario = func() *Scenario {
-	var gs GameScenario = firstmatch.Scenario()
-
-	// TODO: Select which scenario to use based on some configuration or choice,
-	// so it's easier to run different scenarios without changing code.
-	//gs = battleroyal.Scenario()
-	//gs = teamshooter.Scenario()
-	s := backfill.Scenario()
-	gs = s
-
-	return &Scenario{
-		FrontendTotalTicketsToCreate:    -1,
-		FrontendTicketCreatedQPS:        100,
-		FrontendCreatesBackfillsOnStart: true,
-		FrontendTotalBackfillsToCreate:  1000,
-		FrontendDeletesTickets:          true,
-
-		BackendAssignsTickets:        false,
-		BackendAcknowledgesBackfills: true,
-		BackendDeletesBackfills:      true,
-
-		Ticket:             gs.Ticket,
-		Backfill:           gs.Backfill,
-		BackfillDeleteCond: s.BackfillDeleteCond,
-		Profiles:           gs.Profiles,
-
-		MMF:       queryPoolsWrapper(gs.MatchFunction),
-		Evaluator: gs.Evaluate,
-	}
-}()
-
-// Scenario defines the controllable fields for Open Match benchmark scenarios
+// Scenario defines the controllable fields for Open Match benchmark scenarios.
+// It is read from the configuration and flags by FromConfig.
 type Scenario struct {
+	// Name of the game scenario, see GameScenarios.
+	Name string
+	// Duration of the run, 0 to run until shutdown.
+	Duration time.Duration
+	// SummaryFile is the file the summary of the run is written to, if set.
+	SummaryFile string
+
 	// TODO: supports the following controllable parameters
 
 	// MatchFunction Configs
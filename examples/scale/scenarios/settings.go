@@ -0,0 +1,192 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scenarios
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"open-match.dev/open-match/examples/scale/scenarios/backfill"
+	"open-match.dev/open-match/examples/scale/scenarios/battleroyal"
+	"open-match.dev/open-match/examples/scale/scenarios/firstmatch"
+	"open-match.dev/open-match/examples/scale/scenarios/teamshooter"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// DefaultScenario is the game scenario run when none is configured.
+const DefaultScenario = "backfill"
+
+// GameScenarios are the game scenarios which can be run, by name.
+var GameScenarios = map[string]func() GameScenario{
+	"firstmatch":  func() GameScenario { return firstmatch.Scenario() },
+	"battleroyal": func() GameScenario { return battleroyal.Scenario() },
+	"teamshooter": func() GameScenario { return teamshooter.Scenario() },
+	"backfill":    func() GameScenario { return backfill.Scenario() },
+}
+
+// Names returns the sorted names of the GameScenarios.
+func Names() []string {
+	names := make([]string, 0, len(GameScenarios))
+	for name := range GameScenarios {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// knob is a setting of the Scenario, read from the scale section of the
+// configuration or from a flag.
+type knob struct {
+	key   string
+	flag  string
+	usage string
+	set   func(s *Scenario, v string) error
+}
+
+var scenarioKnob = knob{
+	key:   "scale.scenario",
+	flag:  "scenario",
+	usage: "Game scenario to run, one of " + strings.Join(Names(), ", ") + ".",
+}
+
+var knobs = []knob{
+	{"scale.duration", "duration", "Length of the run, after which the load stops and the summary is written. 0 runs forever.", durationKnob(func(s *Scenario) *time.Duration { return &s.Duration })},
+	{"scale.summaryFile", "summary_file", "File the JSON summary of the run is written to, in addition to the logs.", func(s *Scenario, v string) error { s.SummaryFile = v; return nil }},
+	{"scale.frontend.ticketCreatedQPS", "ticket_qps", "Tickets created per second by scale-frontend.", func(s *Scenario, v string) error {
+		n, err := strconv.ParseUint(v, 10, 32)
+		s.FrontendTicketCreatedQPS = uint32(n)
+		return err
+	}},
+	{"scale.frontend.totalTicketsToCreate", "total_tickets", "Tickets created by scale-frontend before it stops, -1 for no limit.", intKnob(func(s *Scenario) *int { return &s.FrontendTotalTicketsToCreate })},
+	{"scale.frontend.totalBackfillsToCreate", "total_backfills", "Backfills created by scale-frontend on start.", intKnob(func(s *Scenario) *int { return &s.FrontendTotalBackfillsToCreate })},
+	{"scale.frontend.createsBackfillsOnStart", "create_backfills", "Whether scale-frontend creates backfills on start.", boolKnob(func(s *Scenario) *bool { return &s.FrontendCreatesBackfillsOnStart })},
+	{"scale.frontend.deletesTickets", "delete_tickets", "Whether scale-frontend deletes the tickets once assigned.", boolKnob(func(s *Scenario) *bool { return &s.FrontendDeletesTickets })},
+	{"scale.backend.assignsTickets", "assign_tickets", "Whether scale-backend assigns the tickets of the matches.", boolKnob(func(s *Scenario) *bool { return &s.BackendAssignsTickets })},
+	{"scale.backend.acknowledgesBackfills", "acknowledge_backfills", "Whether scale-backend acknowledges the backfills of the matches.", boolKnob(func(s *Scenario) *bool { return &s.BackendAcknowledgesBackfills })},
+	{"scale.backend.deletesBackfills", "delete_backfills", "Whether scale-backend deletes the acknowledged backfills which are full.", boolKnob(func(s *Scenario) *bool { return &s.BackendDeletesBackfills })},
+}
+
+func intKnob(field func(s *Scenario) *int) func(s *Scenario, v string) error {
+	return func(s *Scenario, v string) (err error) {
+		*field(s), err = strconv.Atoi(v)
+		return err
+	}
+}
+
+func boolKnob(field func(s *Scenario) *bool) func(s *Scenario, v string) error {
+	return func(s *Scenario, v string) (err error) {
+		*field(s), err = strconv.ParseBool(v)
+		return err
+	}
+}
+
+func durationKnob(field func(s *Scenario) *time.Duration) func(s *Scenario, v string) error {
+	return func(s *Scenario, v string) (err error) {
+		*field(s), err = time.ParseDuration(v)
+		return err
+	}
+}
+
+// RegisterFlags defines on fs the flags overriding the scale section of the
+// configuration: -scenario, -duration, -ticket_qps, ...
+func RegisterFlags(fs *flag.FlagSet) {
+	fs.String(scenarioKnob.flag, "", scenarioKnob.usage)
+	for _, k := range knobs {
+		fs.String(k.flag, "", k.usage)
+	}
+}
+
+// FromConfig returns the Scenario selected by the scale section of cfg,
+// with the defaults of the game scenario overridden by the knobs set in cfg,
+// then by the flags set on fs. fs may be nil, or must have been given to
+// RegisterFlags and parsed.
+func FromConfig(cfg config.View, fs *flag.FlagSet) (*Scenario, error) {
+	values := make(map[string]string)
+	for _, k := range append([]knob{scenarioKnob}, knobs...) {
+		if cfg != nil && cfg.IsSet(k.key) {
+			values[k.key] = cfg.GetString(k.key)
+		}
+		if fs != nil {
+			if f := fs.Lookup(k.flag); f != nil && f.Value.String() != "" {
+				values[k.key] = f.Value.String()
+			}
+		}
+	}
+
+	name := DefaultScenario
+	if v, ok := values[scenarioKnob.key]; ok {
+		name = v
+	}
+	s, err := New(name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, k := range knobs {
+		v, ok := values[k.key]
+		if !ok {
+			continue
+		}
+		if err := k.set(s, v); err != nil {
+			return nil, fmt.Errorf("invalid value %q for %s: %w", v, k.key, err)
+		}
+	}
+	return s, nil
+}
+
+// New returns the Scenario running the game scenario name, with its
+// default knobs.
+func New(name string) (*Scenario, error) {
+	newGameScenario, ok := GameScenarios[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown scenario %q, must be one of %s", name, strings.Join(Names(), ", "))
+	}
+	gs := newGameScenario()
+
+	s := &Scenario{
+		Name: name,
+
+		FrontendTotalTicketsToCreate: -1,
+		FrontendTicketCreatedQPS:     100,
+		FrontendDeletesTickets:       true,
+
+		BackendAssignsTickets: true,
+
+		Ticket:             gs.Ticket,
+		Backfill:           gs.Backfill,
+		BackfillDeleteCond: func(*pb.Backfill) bool { return true },
+		Profiles:           gs.Profiles,
+
+		MMF:       queryPoolsWrapper(gs.MatchFunction),
+		Evaluator: gs.Evaluate,
+	}
+
+	// The tickets of the backfill scenario are assigned by acknowledging the
+	// backfills of their matches.
+	if bs, ok := gs.(*backfill.BackfillScenario); ok {
+		s.FrontendCreatesBackfillsOnStart = true
+		s.FrontendTotalBackfillsToCreate = 1000
+		s.BackendAssignsTickets = false
+		s.BackendAcknowledgesBackfills = true
+		s.BackendDeletesBackfills = true
+		s.BackfillDeleteCond = bs.BackfillDeleteCond
+	}
+	return s, nil
+}
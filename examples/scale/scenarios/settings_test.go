@@ -0,0 +1,71 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scenarios
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromConfigDefaults(t *testing.T) {
+	require := require.New(t)
+
+	s, err := FromConfig(viper.New(), nil)
+	require.NoError(err)
+	require.Equal(DefaultScenario, s.Name)
+	require.Equal(uint32(100), s.FrontendTicketCreatedQPS)
+	require.Equal(-1, s.FrontendTotalTicketsToCreate)
+	require.False(s.BackendAssignsTickets)
+	require.True(s.BackendAcknowledgesBackfills)
+	require.Equal(time.Duration(0), s.Duration)
+}
+
+func TestFromConfigFlagsOverrideConfig(t *testing.T) {
+	require := require.New(t)
+
+	cfg := viper.New()
+	cfg.Set("scale.scenario", "teamshooter")
+	cfg.Set("scale.frontend.ticketCreatedQPS", 20)
+	cfg.Set("scale.duration", "10m")
+
+	fs := flag.NewFlagSet("scale", flag.ContinueOnError)
+	RegisterFlags(fs)
+	require.NoError(fs.Parse([]string{"-scenario=firstmatch", "-ticket_qps=50", "-total_tickets=1000"}))
+
+	s, err := FromConfig(cfg, fs)
+	require.NoError(err)
+	require.Equal("firstmatch", s.Name)
+	require.Equal(uint32(50), s.FrontendTicketCreatedQPS)
+	require.Equal(1000, s.FrontendTotalTicketsToCreate)
+	require.Equal(10*time.Minute, s.Duration)
+	require.True(s.BackendAssignsTickets)
+	require.False(s.FrontendCreatesBackfillsOnStart)
+}
+
+func TestFromConfigInvalid(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("scale.scenario", "unknown")
+	_, err := FromConfig(cfg, nil)
+	require.Error(t, err)
+
+	cfg = viper.New()
+	cfg.Set("scale.backend.assignsTickets", "maybe")
+	_, err = FromConfig(cfg, nil)
+	require.Error(t, err)
+}
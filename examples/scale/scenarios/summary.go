@@ -0,0 +1,164 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scenarios
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Summary collects the results of a scale component during a run, and
+// writes them once the run ends.
+type Summary struct {
+	component string
+	scenario  *Scenario
+	started   time.Time
+
+	mu       sync.Mutex
+	counters map[string]int64
+	samples  map[string][]float64
+	once     sync.Once
+}
+
+// SummaryReport is the written form of a Summary.
+type SummaryReport struct {
+	Component     string                  `json:"component"`
+	Scenario      string                  `json:"scenario"`
+	Started       time.Time               `json:"started"`
+	Ended         time.Time               `json:"ended"`
+	Seconds       float64                 `json:"seconds"`
+	Counters      map[string]int64        `json:"counters"`
+	Rates         map[string]float64      `json:"ratesPerSecond"`
+	Distributions map[string]Distribution `json:"distributions,omitempty"`
+}
+
+// Distribution summarizes the samples observed for a value.
+type Distribution struct {
+	Count int     `json:"count"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+	Max   float64 `json:"max"`
+}
+
+// NewSummary returns a Summary of the run of scenario by component, starting
+// now.
+func NewSummary(component string, scenario *Scenario) *Summary {
+	return &Summary{
+		component: component,
+		scenario:  scenario,
+		started:   time.Now(),
+		counters:  make(map[string]int64),
+		samples:   make(map[string][]float64),
+	}
+}
+
+// Add adds n to the counter name.
+func (s *Summary) Add(name string, n int64) {
+	s.mu.Lock()
+	s.counters[name] += n
+	s.mu.Unlock()
+}
+
+// Observe records a sample of the value name.
+func (s *Summary) Observe(name string, v float64) {
+	s.mu.Lock()
+	s.samples[name] = append(s.samples[name], v)
+	s.mu.Unlock()
+}
+
+// Report returns the results collected so far.
+func (s *Summary) Report() *SummaryReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ended := time.Now()
+	r := &SummaryReport{
+		Component:     s.component,
+		Scenario:      s.scenario.Name,
+		Started:       s.started,
+		Ended:         ended,
+		Seconds:       ended.Sub(s.started).Seconds(),
+		Counters:      make(map[string]int64, len(s.counters)),
+		Rates:         make(map[string]float64, len(s.counters)),
+		Distributions: make(map[string]Distribution, len(s.samples)),
+	}
+	for name, n := range s.counters {
+		r.Counters[name] = n
+		if r.Seconds > 0 {
+			r.Rates[name] = float64(n) / r.Seconds
+		}
+	}
+	for name, samples := range s.samples {
+		r.Distributions[name] = distribution(samples)
+	}
+	return r
+}
+
+// Write writes the report of the run to the logs, and to the summary file of
+// the scenario if set. Only the first call writes the report, so that it can
+// be called both at the end of the run and on shutdown.
+func (s *Summary) Write() {
+	s.once.Do(func() {
+		r := s.Report()
+		data, err := json.MarshalIndent(r, "", "  ")
+		if err != nil {
+			logger.WithError(err).Error("failed to encode the summary of the run")
+			return
+		}
+		logger.Infof("Summary of the %s run of %s:\n%s", r.Scenario, r.Component, data)
+
+		if s.scenario.SummaryFile != "" {
+			if err := ioutil.WriteFile(s.scenario.SummaryFile, data, 0644); err != nil {
+				logger.WithError(err).Errorf("failed to write the summary of the run to %s", s.scenario.SummaryFile)
+			}
+		}
+	})
+}
+
+func distribution(samples []float64) Distribution {
+	if len(samples) == 0 {
+		return Distribution{}
+	}
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	return Distribution{
+		Count: len(sorted),
+		Mean:  sum / float64(len(sorted)),
+		P50:   percentile(sorted, 0.5),
+		P90:   percentile(sorted, 0.9),
+		P99:   percentile(sorted, 0.99),
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile p of the sorted samples.
+func percentile(sorted []float64, p float64) float64 {
+	i := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
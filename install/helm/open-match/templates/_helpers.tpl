@@ -184,6 +184,10 @@ targetCPUUtilizationPercentage: {{ .Values.global.kubernetes.horizontalPodAutoSc
 {{- .Values.evaluator.hostName | default (printf "%s-evaluator" (include "openmatch.fullname" . ) ) -}}
 {{- end -}}
 
+{{- define "openmatch.function.hostName" -}}
+{{- .Values.function.hostName | default (printf "%s-function" (include "openmatch.fullname" . ) ) -}}
+{{- end -}}
+
 {{- define "openmatch.configmap.default" -}}
 {{- printf "%s-configmap-default" (include "openmatch.fullname" . ) -}}
 {{- end -}}
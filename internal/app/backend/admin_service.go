@@ -0,0 +1,232 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/clock"
+	"open-match.dev/open-match/internal/events"
+	"open-match.dev/open-match/internal/filter"
+	"open-match.dev/open-match/internal/statestore"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// The adminService lets operators inspect and manipulate the state of Open
+// Match, and export and import it for migrations and disaster recovery.
+type adminService struct {
+	store        statestore.Service
+	clock        clock.Clock
+	synchronizer *synchronizerClient
+	publisher    *events.Publisher
+}
+
+// ExportState streams every Backfill, then every Ticket of the state.
+// Backfills are sent first so that an import restores them before the
+// Tickets they reference.
+func (s *adminService) ExportState(req *pb.ExportStateRequest, stream pb.AdminService_ExportStateServer) error {
+	ctx := stream.Context()
+	backfills, tickets := 0, 0
+
+	err := s.store.ExportBackfills(ctx, func(r *pb.BackfillRecord) error {
+		backfills++
+		return stream.Send(&pb.ExportStateResponse{Record: &pb.ExportStateResponse_Backfill{Backfill: r}})
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.store.ExportTickets(ctx, func(r *pb.TicketRecord) error {
+		tickets++
+		return stream.Send(&pb.ExportStateResponse{Record: &pb.ExportStateResponse_Ticket{Ticket: r}})
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.WithFields(logrus.Fields{
+		"tickets":   tickets,
+		"backfills": backfills,
+	}).Info("exported state")
+	return nil
+}
+
+// ImportState writes the streamed records, applying the conflict policy of
+// the first request.
+func (s *adminService) ImportState(stream pb.AdminService_ImportStateServer) error {
+	ctx := stream.Context()
+	resp := &pb.ImportStateResponse{}
+	first := true
+	var policy pb.ImportStateRequest_ConflictPolicy
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if first {
+			policy = req.GetConflictPolicy()
+			first = false
+		}
+		overwrite := policy == pb.ImportStateRequest_OVERWRITE
+
+		var id string
+		switch r := req.GetRecord().(type) {
+		case *pb.ImportStateRequest_Ticket:
+			id = r.Ticket.GetTicket().GetId()
+			err = s.store.ImportTicket(ctx, r.Ticket, overwrite)
+			if err == nil {
+				resp.TicketsImported++
+			}
+		case *pb.ImportStateRequest_Backfill:
+			id = r.Backfill.GetBackfill().GetId()
+			err = s.store.ImportBackfill(ctx, r.Backfill, overwrite)
+			if err == nil {
+				resp.BackfillsImported++
+			}
+		default:
+			return status.Error(codes.InvalidArgument, ".record is required")
+		}
+
+		switch status.Code(err) {
+		case codes.OK:
+		case codes.FailedPrecondition:
+			// Expired since the export.
+			resp.SkippedIds = append(resp.SkippedIds, id)
+		case codes.AlreadyExists:
+			if policy == pb.ImportStateRequest_FAIL {
+				return err
+			}
+			resp.SkippedIds = append(resp.SkippedIds, id)
+		default:
+			return err
+		}
+	}
+
+	logger.WithFields(logrus.Fields{
+		"tickets":   resp.TicketsImported,
+		"backfills": resp.BackfillsImported,
+		"skipped":   len(resp.SkippedIds),
+	}).Info("imported state")
+	return stream.SendAndClose(resp)
+}
+
+// ListPendingTickets lists the Tickets pending release.
+func (s *adminService) ListPendingTickets(ctx context.Context, req *pb.ListPendingTicketsRequest) (*pb.ListPendingTicketsResponse, error) {
+	tickets, err := s.store.ListPendingTickets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListPendingTicketsResponse{Tickets: tickets}, nil
+}
+
+// ListBackfills lists every Backfill, sorted by ID.
+func (s *adminService) ListBackfills(ctx context.Context, req *pb.ListBackfillsRequest) (*pb.ListBackfillsResponse, error) {
+	resp := &pb.ListBackfillsResponse{}
+	err := s.store.ExportBackfills(ctx, func(r *pb.BackfillRecord) error {
+		resp.Backfills = append(resp.Backfills, r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(resp.Backfills, func(i, j int) bool {
+		return resp.Backfills[i].GetBackfill().GetId() < resp.Backfills[j].GetBackfill().GetId()
+	})
+	return resp, nil
+}
+
+// ForceReleaseTickets removes the Tickets from the pending release.
+func (s *adminService) ForceReleaseTickets(ctx context.Context, req *pb.ForceReleaseTicketsRequest) (*pb.ForceReleaseTicketsResponse, error) {
+	if err := doReleaseTickets(ctx, req.GetTicketIds(), s.store); err != nil {
+		return nil, err
+	}
+	s.publisher.PublishIDs(pb.TicketEvent_RELEASED, req.GetTicketIds()...)
+
+	logger.WithField("ticketIds", req.GetTicketIds()).Info("force released tickets")
+	return &pb.ForceReleaseTicketsResponse{}, nil
+}
+
+// ForceExpireBackfill expires the Backfill, then deletes it and releases its
+// Tickets as the synchronizer would on its next cycle. The Backfill is
+// expired first so that it cannot be acknowledged while it is deleted.
+func (s *adminService) ForceExpireBackfill(ctx context.Context, req *pb.ForceExpireBackfillRequest) (*pb.ForceExpireBackfillResponse, error) {
+	id := req.GetBackfillId()
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, ".backfill_id is required")
+	}
+
+	_, ticketIDs, err := s.store.GetBackfill(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.ExpireBackfill(ctx, id); err != nil {
+		return nil, err
+	}
+	if err := s.store.DeleteBackfillCompletely(ctx, id); err != nil {
+		return nil, err
+	}
+	s.publisher.PublishIDs(pb.TicketEvent_RELEASED, ticketIDs...)
+
+	logger.WithFields(logrus.Fields{
+		"backfillId": id,
+		"ticketIds":  ticketIDs,
+	}).Info("force expired backfill")
+	return &pb.ForceExpireBackfillResponse{}, nil
+}
+
+// EvaluatePool evaluates the filters of the Pool against the Ticket.
+func (s *adminService) EvaluatePool(ctx context.Context, req *pb.EvaluatePoolRequest) (*pb.EvaluatePoolResponse, error) {
+	if req.GetTicketId() == "" {
+		return nil, status.Error(codes.InvalidArgument, ".ticket_id is required")
+	}
+	if req.GetPool() == nil {
+		return nil, status.Error(codes.InvalidArgument, ".pool is required")
+	}
+
+	pf, err := filter.NewPoolFilterAt(req.GetPool(), s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	record, err := s.store.GetTicketRecord(ctx, req.GetTicketId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.EvaluatePoolResponse{Ticket: record, InPool: true}
+	if r := pf.Explain(record.GetTicket()); r != nil {
+		resp.InPool = false
+		resp.FailedFilter = r.Filter
+		resp.Reason = r.Reason
+	}
+	return resp, nil
+}
+
+// GetSynchronizerStatus returns the status of the synchronizer cycles.
+func (s *adminService) GetSynchronizerStatus(ctx context.Context, req *pb.GetSynchronizerStatusRequest) (*pb.GetSynchronizerStatusResponse, error) {
+	resp, err := s.synchronizer.status(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to get the synchronizer status: %v", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/clock"
+	statestoreTesting "open-match.dev/open-match/internal/statestore/testing"
+	utilTesting "open-match.dev/open-match/internal/util/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestAdminEvaluatePool(t *testing.T) {
+	require := require.New(t)
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+	s := &adminService{store: store, clock: clock.Real}
+
+	ticket := &pb.Ticket{Id: "ticket-1", SearchFields: &pb.SearchFields{
+		DoubleArgs: map[string]float64{"skill": 50},
+	}}
+	require.NoError(store.CreateTicket(ctx, ticket))
+	require.NoError(store.IndexTicket(ctx, ticket))
+
+	resp, err := s.EvaluatePool(ctx, &pb.EvaluatePoolRequest{
+		TicketId: ticket.GetId(),
+		Pool: &pb.Pool{DoubleRangeFilters: []*pb.DoubleRangeFilter{
+			{DoubleArg: "skill", Min: 0, Max: 100},
+			{DoubleArg: "level", Min: 0, Max: 10},
+		}},
+	})
+	require.NoError(err)
+	require.False(resp.GetInPool())
+	require.Equal("double_range_filters[1]", resp.GetFailedFilter())
+	require.Contains(resp.GetReason(), "missing")
+	require.True(resp.GetTicket().GetIndexed())
+
+	resp, err = s.EvaluatePool(ctx, &pb.EvaluatePoolRequest{
+		TicketId: ticket.GetId(),
+		Pool:     &pb.Pool{DoubleRangeFilters: []*pb.DoubleRangeFilter{{DoubleArg: "skill", Min: 0, Max: 100}}},
+	})
+	require.NoError(err)
+	require.True(resp.GetInPool())
+	require.Empty(resp.GetFailedFilter())
+
+	_, err = s.EvaluatePool(ctx, &pb.EvaluatePoolRequest{TicketId: "unknown", Pool: &pb.Pool{}})
+	require.Equal(codes.NotFound, status.Code(err))
+	_, err = s.EvaluatePool(ctx, &pb.EvaluatePoolRequest{TicketId: ticket.GetId()})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestAdminForceRelease(t *testing.T) {
+	require := require.New(t)
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+	s := &adminService{store: store}
+
+	tickets := createPendingTickets(ctx, t, store, 2)
+	pending, err := s.ListPendingTickets(ctx, &pb.ListPendingTicketsRequest{})
+	require.NoError(err)
+	require.Len(pending.GetTickets(), 2)
+
+	_, err = s.ForceReleaseTickets(ctx, &pb.ForceReleaseTicketsRequest{TicketIds: []string{tickets[0].GetId()}})
+	require.NoError(err)
+	pending, err = s.ListPendingTickets(ctx, &pb.ListPendingTicketsRequest{})
+	require.NoError(err)
+	require.Len(pending.GetTickets(), 1)
+	require.Equal(tickets[1].GetId(), pending.GetTickets()[0].GetTicketId())
+
+	backfill := &pb.Backfill{Id: "backfill-1", Generation: 1}
+	require.NoError(store.CreateBackfill(ctx, backfill, []string{tickets[1].GetId()}))
+	backfills, err := s.ListBackfills(ctx, &pb.ListBackfillsRequest{})
+	require.NoError(err)
+	require.Len(backfills.GetBackfills(), 1)
+	require.Equal([]string{tickets[1].GetId()}, backfills.GetBackfills()[0].GetTicketIds())
+	require.NotNil(backfills.GetBackfills()[0].GetLastAcknowledgedTime())
+
+	_, err = s.ForceExpireBackfill(ctx, &pb.ForceExpireBackfillRequest{BackfillId: backfill.GetId()})
+	require.NoError(err)
+	backfills, err = s.ListBackfills(ctx, &pb.ListBackfillsRequest{})
+	require.NoError(err)
+	require.Empty(backfills.GetBackfills())
+	pending, err = s.ListPendingTickets(ctx, &pb.ListPendingTicketsRequest{})
+	require.NoError(err)
+	require.Empty(pending.GetTickets())
+
+	_, err = s.ForceExpireBackfill(ctx, &pb.ForceExpireBackfillRequest{BackfillId: backfill.GetId()})
+	require.Equal(codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,377 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/stats"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/events"
+	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/internal/statestore"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	configNameAllocatorFake       = "allocator.fake"
+	configNameAllocatorTimeout    = "allocator.timeout"
+	configNameAllocatorMaxRetries = "allocator.maxRetries"
+
+	defaultAllocatorTimeout    = 30 * time.Second
+	defaultAllocatorMaxRetries = 3
+)
+
+var errNoAllocatorType = status.Errorf(codes.FailedPrecondition, "unable to determine allocator type, either api.allocator.grpcport or api.allocator.httpport must be specified in the config")
+
+// allocator obtains a GameServer for a match accepted by the evaluator.
+type allocator interface {
+	allocate(context.Context, *pb.Match) (*pb.Assignment, error)
+}
+
+// newAllocator returns the allocator configured under api.allocator, or the
+// local fake allocator if allocator.fake is set. It returns nil if no
+// allocator is configured.
+func newAllocator(cfg config.View) allocator {
+	if cfg.GetBool(configNameAllocatorFake) {
+		return &fakeAllocator{}
+	}
+	if !cfg.IsSet("api.allocator.grpcport") && !cfg.IsSet("api.allocator.httpport") {
+		return nil
+	}
+
+	newInstance := func(cfg config.View) (interface{}, func(), error) {
+		// grpc is preferred over http.
+		if cfg.IsSet("api.allocator.grpcport") {
+			return newGrpcAllocator(cfg)
+		}
+		if cfg.IsSet("api.allocator.httpport") {
+			return newHTTPAllocator(cfg)
+		}
+		return nil, nil, errNoAllocatorType
+	}
+
+	return &deferredAllocator{
+		cacher: config.NewCacher(cfg, newInstance),
+	}
+}
+
+type deferredAllocator struct {
+	cacher *config.Cacher
+}
+
+func (da *deferredAllocator) allocate(ctx context.Context, match *pb.Match) (*pb.Assignment, error) {
+	a, err := da.cacher.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := a.(allocator).allocate(ctx, match)
+	if status.Code(err) == codes.Unavailable {
+		da.cacher.ForceReset()
+	}
+	return assignment, err
+}
+
+type grpcAllocatorClient struct {
+	allocator pb.AllocatorClient
+}
+
+func newGrpcAllocator(cfg config.View) (allocator, func(), error) {
+	grpcAddr := fmt.Sprintf("%s:%d", cfg.GetString("api.allocator.hostname"), cfg.GetInt64("api.allocator.grpcport"))
+	conn, err := rpc.GRPCClientFromEndpoint(cfg, grpcAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create grpc allocator client: %w", err)
+	}
+
+	logger.WithFields(logrus.Fields{
+		"endpoint": grpcAddr,
+	}).Info("Created a GRPC client for allocator endpoint.")
+
+	close := func() {
+		err := conn.Close()
+		if err != nil {
+			logger.WithError(err).Warning("Error closing allocator client.")
+		}
+	}
+
+	return &grpcAllocatorClient{
+		allocator: pb.NewAllocatorClient(conn),
+	}, close, nil
+}
+
+func (ac *grpcAllocatorClient) allocate(ctx context.Context, match *pb.Match) (*pb.Assignment, error) {
+	resp, err := ac.allocator.Allocate(ctx, &pb.AllocateRequest{Match: match})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetAssignment() == nil {
+		return nil, status.Errorf(codes.Internal, "allocator returned no assignment for match %s", match.GetMatchId())
+	}
+	return resp.GetAssignment(), nil
+}
+
+type httpAllocatorClient struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+func newHTTPAllocator(cfg config.View) (allocator, func(), error) {
+	httpAddr := fmt.Sprintf("%s:%d", cfg.GetString("api.allocator.hostname"), cfg.GetInt64("api.allocator.httpport"))
+	client, baseURL, err := rpc.HTTPClientFromEndpoint(cfg, httpAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get a HTTP client from the endpoint %v: %w", httpAddr, err)
+	}
+
+	logger.WithFields(logrus.Fields{
+		"endpoint": httpAddr,
+	}).Info("Created a HTTP client for allocator endpoint.")
+
+	close := func() {
+		client.CloseIdleConnections()
+	}
+
+	return &httpAllocatorClient{
+		httpClient: client,
+		baseURL:    baseURL,
+	}, close, nil
+}
+
+func (ac *httpAllocatorClient) allocate(ctx context.Context, match *pb.Match) (*pb.Assignment, error) {
+	var m jsonpb.Marshaler
+	strReq, err := m.MarshalToString(&pb.AllocateRequest{Match: match})
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to marshal match pb to string for match %s: %s", match.GetMatchId(), err.Error())
+	}
+
+	req, err := http.NewRequest("POST", ac.baseURL+"/v1/allocator/matches:allocate", strings.NewReader(strReq))
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to create allocator http request for match %s: %s", match.GetMatchId(), err.Error())
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := ac.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to get response from allocator for match %s: %s", match.GetMatchId(), err.Error())
+	}
+	defer func() {
+		err = resp.Body.Close()
+		if err != nil {
+			logger.WithError(err).Warning("failed to close response body read closer")
+		}
+	}()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to read response from allocator for match %s: %s", match.GetMatchId(), err.Error())
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, status.Errorf(codes.Unavailable, "allocator responded with status %d for match %s: %s", resp.StatusCode, match.GetMatchId(), body)
+	}
+
+	allocateResp := &pb.AllocateResponse{}
+	if err = jsonpb.UnmarshalString(string(body), allocateResp); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to execute jsonpb.UnmarshalString(%s, &resp): %v", body, err)
+	}
+	if allocateResp.GetAssignment() == nil {
+		return nil, status.Errorf(codes.Internal, "allocator returned no assignment for match %s", match.GetMatchId())
+	}
+	return allocateResp.GetAssignment(), nil
+}
+
+// fakeAllocator allocates imaginary GameServers, for local testing.
+type fakeAllocator struct{}
+
+func (fakeAllocator) allocate(ctx context.Context, match *pb.Match) (*pb.Assignment, error) {
+	return &pb.Assignment{Connection: "fake-gameserver-" + match.GetMatchId()}, nil
+}
+
+// allocationHandler allocates GameServers for the matches with
+// allocate_gameserver set, and assigns their tickets.
+type allocationHandler struct {
+	cfg       config.View
+	allocator allocator
+	store     statestore.Service
+	publisher *events.Publisher
+
+	// ctx is canceled by close, which waits for the allocations in wg.
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newAllocationHandler returns nil if no allocator is configured.
+func newAllocationHandler(cfg config.View, store statestore.Service, publisher *events.Publisher) *allocationHandler {
+	a := newAllocator(cfg)
+	if a == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &allocationHandler{
+		cfg:       cfg,
+		allocator: a,
+		store:     store,
+		publisher: publisher,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// start handles the match in the background, until close is called.
+func (ah *allocationHandler) start(match *pb.Match) {
+	ah.wg.Add(1)
+	go func() {
+		defer ah.wg.Done()
+		ah.handle(ah.ctx, match)
+	}()
+}
+
+// close cancels the allocations in progress and waits for them to return.
+// The tickets of the canceled allocations return to the pool once their
+// pending release times out.
+func (ah *allocationHandler) close() {
+	ah.cancel()
+	ah.wg.Wait()
+}
+
+// handle allocates a GameServer for the match, retrying failed attempts, and
+// assigns the tickets of the match or of its backfill. The tickets are
+// released if no GameServer could be allocated.
+func (ah *allocationHandler) handle(ctx context.Context, match *pb.Match) {
+	timeout := defaultAllocatorTimeout
+	if ah.cfg.IsSet(configNameAllocatorTimeout) {
+		timeout = ah.cfg.GetDuration(configNameAllocatorTimeout)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	matchLogger := logger.WithFields(logrus.Fields{
+		"match_id": match.GetMatchId(),
+	})
+
+	assignment, err := ah.allocate(ctx, match)
+	if err != nil {
+		stats.Record(ctx, allocationsFailed.M(1))
+		matchLogger.WithError(err).Error("failed to allocate a game server, releasing the match")
+		ah.release(ctx, match)
+		return
+	}
+	stats.Record(ctx, allocationsSucceeded.M(1))
+
+	if backfill := match.GetBackfill(); backfill != nil {
+		err = ah.assignBackfill(ctx, backfill.GetId(), assignment)
+	} else {
+		err = ah.assignTickets(ctx, match, assignment)
+	}
+	if err != nil {
+		matchLogger.WithError(err).Error("failed to assign the allocated game server")
+	}
+}
+
+func (ah *allocationHandler) allocate(ctx context.Context, match *pb.Match) (*pb.Assignment, error) {
+	maxRetries := defaultAllocatorMaxRetries
+	if ah.cfg.IsSet(configNameAllocatorMaxRetries) {
+		maxRetries = ah.cfg.GetInt(configNameAllocatorMaxRetries)
+	}
+
+	b := backoff.NewExponentialBackOff()
+	if ah.cfg.IsSet("backoff.initialInterval") {
+		b.InitialInterval = ah.cfg.GetDuration("backoff.initialInterval")
+		b.RandomizationFactor = ah.cfg.GetFloat64("backoff.randFactor")
+		b.Multiplier = ah.cfg.GetFloat64("backoff.multiplier")
+		b.MaxInterval = ah.cfg.GetDuration("backoff.maxInterval")
+		b.MaxElapsedTime = ah.cfg.GetDuration("backoff.maxElapsedTime")
+	}
+
+	var assignment *pb.Assignment
+	err := backoff.Retry(func() error {
+		var err error
+		assignment, err = ah.allocator.allocate(ctx, match)
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.FailedPrecondition:
+			return backoff.Permanent(err)
+		}
+		return err
+	}, backoff.WithContext(backoff.WithMaxRetries(b, uint64(maxRetries)), ctx))
+	return assignment, err
+}
+
+func (ah *allocationHandler) assignTickets(ctx context.Context, match *pb.Match, assignment *pb.Assignment) error {
+	ids := make([]string, 0, len(match.GetTickets()))
+	for _, t := range match.GetTickets() {
+		ids = append(ids, t.GetId())
+	}
+
+	resp, tickets, err := doAssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{TicketIds: ids, Assignment: assignment}},
+	}, ah.store)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range resp.GetFailures() {
+		logger.Errorf("failed to assign ticket %s, cause %d", f.TicketId, f.Cause)
+	}
+	stats.Record(ctx, ticketsAssigned.M(int64(len(tickets))))
+	ah.publisher.Publish(pb.TicketEvent_ASSIGNED, tickets...)
+	return nil
+}
+
+// assignBackfill acknowledges the backfill and assigns its tickets, as
+// FrontendService.AcknowledgeBackfill does for GameServers allocated by a director.
+func (ah *allocationHandler) assignBackfill(ctx context.Context, id string, assignment *pb.Assignment) error {
+	_, tickets, err := ah.store.AcknowledgeBackfill(ctx, id, assignment)
+	if err != nil {
+		return err
+	}
+	stats.Record(ctx, ticketsAssigned.M(int64(len(tickets))))
+	ah.publisher.Publish(pb.TicketEvent_ASSIGNED, tickets...)
+	return nil
+}
+
+// release makes the tickets of a match available for matchmaking again. A
+// backfill without GameServer is deleted along with its tickets' pending state.
+func (ah *allocationHandler) release(ctx context.Context, match *pb.Match) {
+	if backfill := match.GetBackfill(); backfill != nil {
+		err := ah.store.DeleteBackfillCompletely(ctx, backfill.GetId())
+		if err != nil {
+			logger.WithError(err).Errorf("failed to delete backfill %s", backfill.GetId())
+		}
+		return
+	}
+
+	ids := make([]string, 0, len(match.GetTickets()))
+	for _, t := range match.GetTickets() {
+		ids = append(ids, t.GetId())
+	}
+	err := doReleaseTickets(ctx, ids, ah.store)
+	if err != nil {
+		logger.WithError(err).Errorf("failed to remove match tickets from pending release: %v", ids)
+		return
+	}
+	ah.publisher.PublishIDs(pb.TicketEvent_RELEASED, ids...)
+}
@@ -0,0 +1,251 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/rs/xid"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/statestore"
+	statestoreTesting "open-match.dev/open-match/internal/statestore/testing"
+	utilTesting "open-match.dev/open-match/internal/util/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+type failingAllocator struct {
+	calls int
+	err   error
+}
+
+func (a *failingAllocator) allocate(ctx context.Context, match *pb.Match) (*pb.Assignment, error) {
+	a.calls++
+	return nil, a.err
+}
+
+// blockingAllocator allocates once its context is done.
+type blockingAllocator struct {
+	once    sync.Once
+	started chan struct{}
+}
+
+func (a *blockingAllocator) allocate(ctx context.Context, match *pb.Match) (*pb.Assignment, error) {
+	a.once.Do(func() { close(a.started) })
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func createPendingTickets(ctx context.Context, t *testing.T, store statestore.Service, n int) []*pb.Ticket {
+	tickets := make([]*pb.Ticket, 0, n)
+	ids := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		ticket, err := doCreateTestTicket(ctx, store)
+		require.NoError(t, err)
+		tickets = append(tickets, ticket)
+		ids = append(ids, ticket.Id)
+	}
+	require.NoError(t, store.AddTicketsToPendingRelease(ctx, ids))
+	return tickets
+}
+
+func doCreateTestTicket(ctx context.Context, store statestore.Service) (*pb.Ticket, error) {
+	ticket := &pb.Ticket{Id: xid.New().String(), Generation: 1}
+	err := store.CreateTicket(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	return ticket, store.IndexTicket(ctx, ticket)
+}
+
+func TestNewAllocationHandler(t *testing.T) {
+	require.Nil(t, newAllocationHandler(viper.New(), nil, nil))
+
+	cfg := viper.New()
+	cfg.Set("allocator.fake", true)
+	require.NotNil(t, newAllocationHandler(cfg, nil, nil))
+
+	cfg = viper.New()
+	cfg.Set("api.allocator.hostname", "localhost")
+	cfg.Set("api.allocator.httpport", 1234)
+	require.NotNil(t, newAllocationHandler(cfg, nil, nil))
+}
+
+func TestAllocationHandlerAssignsTickets(t *testing.T) {
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+
+	cfg.Set("allocator.fake", true)
+	ah := newAllocationHandler(cfg, store, nil)
+
+	tickets := createPendingTickets(ctx, t, store, 2)
+	ah.handle(ctx, &pb.Match{MatchId: "1", Tickets: tickets, AllocateGameserver: true})
+
+	for _, ticket := range tickets {
+		stored, err := store.GetTicket(ctx, ticket.Id)
+		require.NoError(t, err)
+		require.Equal(t, "fake-gameserver-1", stored.GetAssignment().GetConnection())
+	}
+
+	indexed, err := store.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Empty(t, indexed)
+}
+
+func TestAllocationHandlerReleasesOnFailure(t *testing.T) {
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+
+	cfg.Set("allocator.maxRetries", 2)
+	allocator := &failingAllocator{err: status.Error(codes.Unavailable, "no game server available")}
+	ah := &allocationHandler{cfg: cfg, allocator: allocator, store: store}
+
+	tickets := createPendingTickets(ctx, t, store, 2)
+	indexed, err := store.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Empty(t, indexed)
+
+	ah.handle(ctx, &pb.Match{MatchId: "1", Tickets: tickets, AllocateGameserver: true})
+	require.Equal(t, 3, allocator.calls)
+
+	// The tickets are returned to the pool without assignment.
+	indexed, err = store.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, indexed, 2)
+	for _, ticket := range tickets {
+		stored, err := store.GetTicket(ctx, ticket.Id)
+		require.NoError(t, err)
+		require.Nil(t, stored.GetAssignment())
+	}
+
+	// Invalid matches are not retried.
+	allocator = &failingAllocator{err: status.Error(codes.InvalidArgument, "bad match")}
+	ah.allocator = allocator
+	ah.handle(ctx, &pb.Match{MatchId: "2", Tickets: tickets, AllocateGameserver: true})
+	require.Equal(t, 1, allocator.calls)
+}
+
+func TestAllocationHandlerAssignsBackfill(t *testing.T) {
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+
+	cfg.Set("allocator.fake", true)
+	ah := newAllocationHandler(cfg, store, nil)
+
+	tickets := createPendingTickets(ctx, t, store, 2)
+	backfill := &pb.Backfill{Generation: 1}
+	require.NoError(t, createOrUpdateBackfill(ctx, backfill, []string{tickets[0].Id, tickets[1].Id}, store))
+
+	ah.handle(ctx, &pb.Match{MatchId: "1", Tickets: tickets, Backfill: backfill, AllocateGameserver: true})
+
+	for _, ticket := range tickets {
+		stored, err := store.GetTicket(ctx, ticket.Id)
+		require.NoError(t, err)
+		require.Equal(t, "fake-gameserver-1", stored.GetAssignment().GetConnection())
+	}
+	_, associated, err := store.GetBackfill(ctx, backfill.Id)
+	require.NoError(t, err)
+	require.Empty(t, associated)
+}
+
+func TestAllocationHandlerDeletesBackfillOnFailure(t *testing.T) {
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+
+	cfg.Set("allocator.maxRetries", 0)
+	ah := &allocationHandler{cfg: cfg, allocator: &failingAllocator{err: status.Error(codes.Unavailable, "down")}, store: store}
+
+	tickets := createPendingTickets(ctx, t, store, 1)
+	backfill := &pb.Backfill{}
+	require.NoError(t, createOrUpdateBackfill(ctx, backfill, []string{tickets[0].Id}, store))
+
+	ah.handle(ctx, &pb.Match{MatchId: "1", Tickets: tickets, Backfill: backfill, AllocateGameserver: true})
+
+	_, _, err := store.GetBackfill(ctx, backfill.Id)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	indexed, err := store.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, indexed, 1)
+}
+
+func TestAllocationHandlerClose(t *testing.T) {
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+
+	cfg.Set("allocator.fake", true)
+	ah := newAllocationHandler(cfg, store, nil)
+	allocator := &blockingAllocator{started: make(chan struct{})}
+	ah.allocator = allocator
+
+	tickets := createPendingTickets(ctx, t, store, 1)
+	ah.start(&pb.Match{MatchId: "1", Tickets: tickets, AllocateGameserver: true})
+	<-allocator.started
+
+	// The allocation in progress is canceled, and returned once close does.
+	ah.close()
+	stored, err := store.GetTicket(ctx, tickets[0].Id)
+	require.NoError(t, err)
+	require.Nil(t, stored.GetAssignment())
+}
+
+func TestHTTPAllocator(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/allocator/matches:allocate" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		req := &pb.AllocateRequest{}
+		if err := jsonpb.UnmarshalString(string(body), req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		m := jsonpb.Marshaler{}
+		_ = m.Marshal(w, &pb.AllocateResponse{Assignment: &pb.Assignment{Connection: "gs-" + req.GetMatch().GetMatchId()}})
+	}))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	cfg := viper.New()
+	cfg.Set("api.allocator.hostname", u.Hostname())
+	cfg.Set("api.allocator.httpport", u.Port())
+	a := newAllocator(cfg)
+	require.NotNil(t, a)
+
+	assignment, err := a.allocate(context.Background(), &pb.Match{MatchId: "1"})
+	require.NoError(t, err)
+	require.Equal(t, "gs-1", assignment.GetConnection())
+}
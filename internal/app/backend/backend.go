@@ -15,40 +15,63 @@
 package backend
 
 import (
+	"context"
+	"time"
+
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 	"google.golang.org/grpc"
 	"open-match.dev/open-match/internal/appmain"
+	"open-match.dev/open-match/internal/events"
 	"open-match.dev/open-match/internal/rpc"
 	"open-match.dev/open-match/internal/statestore"
 	"open-match.dev/open-match/internal/telemetry"
 	"open-match.dev/open-match/pkg/pb"
 )
 
+const (
+	// configNameEventsSweepInterval is how often the tickets which expired in
+	// the state storage are looked up, to publish their events.
+	configNameEventsSweepInterval = "events.sweepInterval"
+	defaultEventsSweepInterval    = 5 * time.Second
+)
+
 var (
 	totalBytesPerMatch      = stats.Int64("open-match.dev/backend/total_bytes_per_match", "Total bytes per match", stats.UnitBytes)
 	ticketsPerMatch         = stats.Int64("open-match.dev/backend/tickets_per_match", "Number of tickets per match", stats.UnitDimensionless)
 	ticketsReleased         = stats.Int64("open-match.dev/backend/tickets_released", "Number of tickets released per request", stats.UnitDimensionless)
 	ticketsAssigned         = stats.Int64("open-match.dev/backend/tickets_assigned", "Number of tickets assigned per request", stats.UnitDimensionless)
+	ticketsRequeued         = stats.Int64("open-match.dev/backend/tickets_requeued", "Number of tickets requeued per request", stats.UnitDimensionless)
 	ticketsTimeToAssignment = stats.Int64("open-match.dev/backend/ticket_time_to_assignment", "Time to assignment for tickets", stats.UnitMilliseconds)
+	allocationsSucceeded    = stats.Int64("open-match.dev/backend/allocations_succeeded", "Number of game servers allocated for matches", stats.UnitDimensionless)
+	allocationsFailed       = stats.Int64("open-match.dev/backend/allocations_failed", "Number of matches for which no game server could be allocated", stats.UnitDimensionless)
+	watchMatchesEvicted     = stats.Int64("open-match.dev/backend/watch_matches_evicted", "Number of WatchMatches streams closed because they fell behind", stats.UnitDimensionless)
+	fetchMatchesLatency     = stats.Float64("open-match.dev/backend/fetch_matches_latency", "Time to complete a FetchMatches call", stats.UnitMilliseconds)
+	mmfLatency              = stats.Float64("open-match.dev/backend/mmf_latency", "Time to run a match function", stats.UnitMilliseconds)
+
+	matchTagKeys = []tag.Key{telemetry.KeyProfile, telemetry.KeyFunction}
 
 	totalMatchesView = &view.View{
 		Measure:     totalBytesPerMatch,
 		Name:        "open-match.dev/backend/total_matches",
 		Description: "Total number of matches",
 		Aggregation: view.Count(),
+		TagKeys:     matchTagKeys,
 	}
 	totalBytesPerMatchView = &view.View{
 		Measure:     totalBytesPerMatch,
 		Name:        "open-match.dev/backend/total_bytes_per_match",
 		Description: "Total bytes per match",
 		Aggregation: telemetry.DefaultBytesDistribution,
+		TagKeys:     matchTagKeys,
 	}
 	ticketsPerMatchView = &view.View{
 		Measure:     ticketsPerMatch,
 		Name:        "open-match.dev/backend/tickets_per_match",
 		Description: "Tickets per ticket",
 		Aggregation: telemetry.DefaultCountDistribution,
+		TagKeys:     matchTagKeys,
 	}
 	ticketsAssignedView = &view.View{
 		Measure:     ticketsAssigned,
@@ -56,6 +79,12 @@ var (
 		Description: "Number of tickets assigned per request",
 		Aggregation: view.Sum(),
 	}
+	ticketsRequeuedView = &view.View{
+		Measure:     ticketsRequeued,
+		Name:        "open-match.dev/backend/tickets_requeued",
+		Description: "Number of tickets requeued per request",
+		Aggregation: view.Sum(),
+	}
 	ticketsReleasedView = &view.View{
 		Measure:     ticketsReleased,
 		Name:        "open-match.dev/backend/tickets_released",
@@ -69,27 +98,99 @@ var (
 		Description: "Time to assignment for tickets",
 		Aggregation: telemetry.DefaultMillisecondsDistribution,
 	}
+	allocationsSucceededView = &view.View{
+		Measure:     allocationsSucceeded,
+		Name:        "open-match.dev/backend/allocations_succeeded",
+		Description: "Number of game servers allocated for matches",
+		Aggregation: view.Count(),
+	}
+	allocationsFailedView = &view.View{
+		Measure:     allocationsFailed,
+		Name:        "open-match.dev/backend/allocations_failed",
+		Description: "Number of matches for which no game server could be allocated",
+		Aggregation: view.Count(),
+	}
+	watchMatchesEvictedView = &view.View{
+		Measure:     watchMatchesEvicted,
+		Name:        "open-match.dev/backend/watch_matches_evicted",
+		Description: "Number of WatchMatches streams closed because they fell behind",
+		Aggregation: view.Count(),
+	}
+	fetchMatchesLatencyView = &view.View{
+		Measure:     fetchMatchesLatency,
+		Name:        "open-match.dev/backend/fetch_matches_latency",
+		Description: "Time to complete a FetchMatches call",
+		Aggregation: telemetry.DefaultMillisecondsDistribution,
+		TagKeys:     matchTagKeys,
+	}
+	mmfLatencyView = &view.View{
+		Measure:     mmfLatency,
+		Name:        "open-match.dev/backend/mmf_latency",
+		Description: "Time to run a match function",
+		Aggregation: telemetry.DefaultMillisecondsDistribution,
+		TagKeys:     matchTagKeys,
+	}
 )
 
 // BindService creates the backend service and binds it to the serving harness.
 func BindService(p *appmain.Params, b *appmain.Bindings) error {
+	publisher, err := events.BindPublisher(p, b)
+	if err != nil {
+		return err
+	}
+
+	store := statestore.NewWithClock(p.Config(), p.Clock())
 	service := &backendService{
 		synchronizer: newSynchronizerClient(p.Config()),
-		store:        statestore.New(p.Config()),
-		cc:           rpc.NewClientCache(p.Config()),
+		store:        store,
+		mmfs:         newMmfEndpoints(p.Config(), rpc.NewClientCache(p.Config())),
+		publisher:    publisher,
+		watchers:     newMatchWatchers(p.Config(), store),
+		allocations:  newAllocationHandler(p.Config(), store, publisher),
+		tags:         telemetry.NewTagLimiter(p.Config()),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go service.watchers.run(ctx)
+	go service.mmfs.run(ctx)
+	if publisher.Enabled() {
+		interval := defaultEventsSweepInterval
+		if p.Config().IsSet(configNameEventsSweepInterval) {
+			interval = p.Config().GetDuration(configNameEventsSweepInterval)
+		}
+		go sweepExpiredTickets(ctx, p.Clock(), interval, store, publisher)
+	}
+	b.AddCloser(cancel)
+	if service.allocations != nil {
+		b.AddCloser(service.allocations.close)
 	}
 
 	b.AddHealthCheckFunc(service.store.HealthCheck)
 	b.AddHandleFunc(func(s *grpc.Server) {
 		pb.RegisterBackendServiceServer(s, service)
 	}, pb.RegisterBackendServiceHandlerFromEndpoint)
+	b.AddHandleFunc(func(s *grpc.Server) {
+		pb.RegisterAdminServiceServer(s, &adminService{
+			store:        store,
+			clock:        p.Clock(),
+			synchronizer: service.synchronizer,
+			publisher:    publisher,
+		})
+	}, pb.RegisterAdminServiceHandlerFromEndpoint)
 	b.RegisterViews(
 		totalMatchesView,
 		totalBytesPerMatchView,
 		ticketsPerMatchView,
 		ticketsAssignedView,
+		ticketsRequeuedView,
 		ticketsReleasedView,
 		ticketsTimeToAssignmentView,
+		allocationsSucceededView,
+		allocationsFailedView,
+		watchMatchesEvictedView,
+		fetchMatchesLatencyView,
+		mmfLatencyView,
 	)
-	return nil
+	// The latencies are recorded with the trace exemplars.
+	return telemetry.RegisterExemplarViews(fetchMatchesLatencyView, mmfLatencyView)
 }
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
@@ -37,9 +38,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"open-match.dev/open-match/internal/appmain/contextcause"
+	"open-match.dev/open-match/internal/clock"
+	"open-match.dev/open-match/internal/events"
 	"open-match.dev/open-match/internal/ipb"
 	"open-match.dev/open-match/internal/rpc"
 	"open-match.dev/open-match/internal/statestore"
+	"open-match.dev/open-match/internal/telemetry"
 	"open-match.dev/open-match/pkg/pb"
 )
 
@@ -48,7 +52,11 @@ import (
 type backendService struct {
 	synchronizer *synchronizerClient
 	store        statestore.Service
-	cc           *rpc.ClientCache
+	mmfs         *mmfEndpoints
+	publisher    *events.Publisher
+	watchers     *matchWatchers
+	allocations  *allocationHandler
+	tags         *telemetry.TagLimiter
 }
 
 var (
@@ -71,8 +79,25 @@ func (s *backendService) FetchMatches(req *pb.FetchMatchesRequest, stream pb.Bac
 		return status.Error(codes.InvalidArgument, ".profile is required")
 	}
 
+	// The metrics of the call and of its matches are broken down by profile
+	// and match function.
+	start := time.Now()
+	streamCtx, err := tag.New(stream.Context(),
+		s.tags.Upsert(telemetry.KeyProfile, req.GetProfile().GetName()),
+		s.tags.Upsert(telemetry.KeyFunction, functionName(req.GetConfig())),
+	)
+	if err != nil {
+		// The metrics are then recorded without the tags, failing the call
+		// would be worse than losing the breakdown.
+		logger.WithError(err).Warning("failed to tag the FetchMatches metrics")
+		streamCtx = stream.Context()
+	}
+	defer func() {
+		telemetry.RecordWithExemplar(streamCtx, fetchMatchesLatency.M(float64(time.Since(start))/float64(time.Millisecond)))
+	}()
+
 	// Error group for handling the synchronizer calls only.
-	eg, ctx := errgroup.WithContext(stream.Context())
+	eg, ctx := errgroup.WithContext(streamCtx)
 	syncStream, err := s.synchronizer.synchronize(ctx)
 	if err != nil {
 		return err
@@ -91,7 +116,7 @@ func (s *backendService) FetchMatches(req *pb.FetchMatchesRequest, stream pb.Bac
 		return synchronizeSend(ctx, syncStream, m, proposals)
 	})
 	eg.Go(func() error {
-		return synchronizeRecv(ctx, syncStream, m, stream, startMmfs, cancelMmfs, s.store)
+		return synchronizeRecv(ctx, syncStream, m, stream, startMmfs, cancelMmfs, s.store, s.publisher, s.watchers, s.allocations)
 	})
 
 	var mmfErr error
@@ -99,7 +124,9 @@ func (s *backendService) FetchMatches(req *pb.FetchMatchesRequest, stream pb.Bac
 	case <-mmfCtx.Done():
 		mmfErr = fmt.Errorf("mmf was never started")
 	case <-startMmfs:
-		mmfErr = callMmf(mmfCtx, s.cc, req, proposals)
+		mmfStart := time.Now()
+		mmfErr = callMmf(mmfCtx, s.mmfs, req, proposals)
+		telemetry.RecordWithExemplar(streamCtx, mmfLatency.M(float64(time.Since(mmfStart))/float64(time.Millisecond)))
 	}
 
 	syncErr := eg.Wait()
@@ -144,7 +171,7 @@ sendProposals:
 	return nil
 }
 
-func synchronizeRecv(ctx context.Context, syncStream synchronizerStream, m *sync.Map, stream pb.BackendService_FetchMatchesServer, startMmfs chan<- struct{}, cancelMmfs contextcause.CancelErrFunc, store statestore.Service) error {
+func synchronizeRecv(ctx context.Context, syncStream synchronizerStream, m *sync.Map, stream pb.BackendService_FetchMatchesServer, startMmfs chan<- struct{}, cancelMmfs contextcause.CancelErrFunc, store statestore.Service, publisher *events.Publisher, watchers *matchWatchers, allocations *allocationHandler) error {
 	var startMmfsOnce sync.Once
 
 	for {
@@ -187,6 +214,8 @@ func synchronizeRecv(ctx context.Context, syncStream synchronizerStream, m *sync
 						err = doReleaseTickets(ctx, ticketIds, store)
 						if err != nil {
 							logger.WithError(err).Errorf("failed to remove match tickets from pending release: %v", ticketIds)
+						} else {
+							publisher.PublishIDs(pb.TicketEvent_RELEASED, ticketIds...)
 						}
 
 						continue
@@ -202,31 +231,86 @@ func synchronizeRecv(ctx context.Context, syncStream synchronizerStream, m *sync
 			if err != nil {
 				return fmt.Errorf("error sending match to caller of backend: %w", err)
 			}
+			publisher.PublishProposed(match)
+			watchers.publish(match)
+
+			if allocations != nil && match.GetAllocateGameserver() {
+				allocations.start(match)
+			}
 		}
 	}
 }
 
-// callMmf triggers execution of MMFs to fetch match proposals.
-func callMmf(ctx context.Context, cc *rpc.ClientCache, req *pb.FetchMatchesRequest, proposals chan<- *pb.Match) error {
+// callMmf triggers execution of MMFs to fetch match proposals. The call is
+// load balanced between the endpoints of the match function and, if
+// config.retry is set, retried on another endpoint when the match function
+// fails before sending any proposal.
+func callMmf(ctx context.Context, endpoints *mmfEndpoints, req *pb.FetchMatchesRequest, proposals chan<- *pb.Match) error {
 	defer close(proposals)
-	address := fmt.Sprintf("%s:%d", req.GetConfig().GetHost(), req.GetConfig().GetPort())
 
 	switch req.GetConfig().GetType() {
-	case pb.FunctionConfig_GRPC:
-		return callGrpcMmf(ctx, cc, req.GetProfile(), address, proposals)
-	case pb.FunctionConfig_REST:
-		return callHTTPMmf(ctx, cc, req.GetProfile(), address, proposals)
+	case pb.FunctionConfig_GRPC, pb.FunctionConfig_REST:
 	default:
 		return status.Error(codes.InvalidArgument, "provided match function type is not supported")
 	}
+
+	candidates, err := endpoints.resolve(ctx, req.GetConfig())
+	if err != nil {
+		return err
+	}
+	maxAttempts := 1
+	if req.GetConfig().GetRetry() {
+		maxAttempts = endpoints.maxAttempts
+		if maxAttempts > len(candidates) {
+			maxAttempts = len(candidates)
+		}
+	}
+
+	sent := 0
+	send := func(m *pb.Match) error {
+		select {
+		case proposals <- m:
+			sent++
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	tried := make(map[mmfEndpoint]bool, maxAttempts)
+	for {
+		ep, err := endpoints.pick(candidates, tried)
+		if err != nil {
+			return err
+		}
+		tried[ep] = true
+
+		switch ep.funcType {
+		case pb.FunctionConfig_GRPC:
+			err = callGrpcMmf(ctx, endpoints.cc, req.GetProfile(), ep.address, send)
+		case pb.FunctionConfig_REST:
+			err = callHTTPMmf(ctx, endpoints.cc, req.GetProfile(), ep.address, send)
+		}
+		endpoints.report(ctx, ep, err)
+
+		if err == nil || sent > 0 || len(tried) >= maxAttempts || !isEndpointFailure(ctx, err) {
+			return err
+		}
+		logger.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"address": ep.address,
+			"profile": req.GetProfile().GetName(),
+		}).Warning("match function failed, retrying on another endpoint")
+	}
 }
 
-func callGrpcMmf(ctx context.Context, cc *rpc.ClientCache, profile *pb.MatchProfile, address string, proposals chan<- *pb.Match) error {
+func callGrpcMmf(ctx context.Context, cc *rpc.ClientCache, profile *pb.MatchProfile, address string, send func(*pb.Match) error) error {
 	var conn *grpc.ClientConn
-	conn, err := cc.GetGRPC(address)
+	conn, release, err := cc.GetGRPC(address)
 	if err != nil {
 		return status.Error(codes.InvalidArgument, "failed to establish grpc client connection to match function")
 	}
+	defer release()
 	client := pb.NewMatchFunctionClient(conn)
 
 	stream, err := client.Run(ctx, &pb.RunRequest{Profile: profile})
@@ -252,22 +336,21 @@ func callGrpcMmf(ctx context.Context, cc *rpc.ClientCache, profile *pb.MatchProf
 			}
 			return err
 		}
-		select {
-		case proposals <- resp.GetProposal():
-		case <-ctx.Done():
-			return ctx.Err()
+		if err := send(resp.GetProposal()); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-func callHTTPMmf(ctx context.Context, cc *rpc.ClientCache, profile *pb.MatchProfile, address string, proposals chan<- *pb.Match) error {
-	client, baseURL, err := cc.GetHTTP(address)
+func callHTTPMmf(ctx context.Context, cc *rpc.ClientCache, profile *pb.MatchProfile, address string, send func(*pb.Match) error) error {
+	client, baseURL, release, err := cc.GetHTTP(address)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to establish rest client connection to match function: %s", address)
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
+	defer release()
 
 	var m jsonpb.Marshaler
 	strReq, err := m.MarshalToString(&pb.RunRequest{Profile: profile})
@@ -312,10 +395,8 @@ func callHTTPMmf(ctx context.Context, cc *rpc.ClientCache, profile *pb.MatchProf
 		if err := jsonpb.UnmarshalString(string(item.Result), resp); err != nil {
 			return status.Errorf(codes.Unavailable, "failed to execute json.Unmarshal(%s, &resp): %v", item.Result, err)
 		}
-		select {
-		case proposals <- resp.GetProposal():
-		case <-ctx.Done():
-			return ctx.Err()
+		if err := send(resp.GetProposal()); err != nil {
+			return err
 		}
 	}
 
@@ -328,6 +409,8 @@ func (s *backendService) ReleaseTickets(ctx context.Context, req *pb.ReleaseTick
 		return nil, err
 	}
 
+	s.publisher.PublishIDs(pb.TicketEvent_RELEASED, req.GetTicketIds()...)
+
 	return &pb.ReleaseTicketsResponse{}, nil
 }
 
@@ -352,10 +435,11 @@ func (s *backendService) ReleaseAllTickets(ctx context.Context, req *pb.ReleaseA
 
 // AssignTickets overwrites the Assignment field of the input TicketIds.
 func (s *backendService) AssignTickets(ctx context.Context, req *pb.AssignTicketsRequest) (*pb.AssignTicketsResponse, error) {
-	resp, err := doAssignTickets(ctx, req, s.store)
+	resp, tickets, err := doAssignTickets(ctx, req, s.store)
 	if err != nil {
 		return nil, err
 	}
+	s.publisher.Publish(pb.TicketEvent_ASSIGNED, tickets...)
 
 	numIds := 0
 	for _, ag := range req.Assignments {
@@ -416,10 +500,10 @@ func createOrUpdateBackfill(ctx context.Context, backfill *pb.Backfill, ticketId
 	return store.IndexBackfill(ctx, b)
 }
 
-func doAssignTickets(ctx context.Context, req *pb.AssignTicketsRequest, store statestore.Service) (*pb.AssignTicketsResponse, error) {
+func doAssignTickets(ctx context.Context, req *pb.AssignTicketsRequest, store statestore.Service) (*pb.AssignTicketsResponse, []*pb.Ticket, error) {
 	resp, tickets, err := store.UpdateAssignments(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	for _, ticket := range tickets {
@@ -450,7 +534,7 @@ func doAssignTickets(ctx context.Context, req *pb.AssignTicketsRequest, store st
 		}).Error(err)
 	}
 
-	return resp, nil
+	return resp, tickets, nil
 }
 
 func recordTimeToAssignment(ctx context.Context, ticket *pb.Ticket) error {
@@ -468,3 +552,61 @@ func recordTimeToAssignment(ctx context.Context, ticket *pb.Ticket) error {
 
 	return nil
 }
+
+// RequeueTickets clears the Assignment of the input TicketIds and makes them available for matchmaking again.
+func (s *backendService) RequeueTickets(ctx context.Context, req *pb.RequeueTicketsRequest) (*pb.RequeueTicketsResponse, error) {
+	resp, tickets, err := doRequeueTickets(ctx, req.GetTicketIds(), s.store)
+	if err != nil {
+		return nil, err
+	}
+	s.publisher.Publish(pb.TicketEvent_REQUEUED, tickets...)
+
+	stats.Record(ctx, ticketsRequeued.M(int64(len(req.GetTicketIds())-len(resp.Failures))))
+	return resp, nil
+}
+
+func doRequeueTickets(ctx context.Context, ticketIds []string, store statestore.Service) (*pb.RequeueTicketsResponse, []*pb.Ticket, error) {
+	resp, tickets, err := store.RequeueTickets(ctx, ticketIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logger.WithFields(logrus.Fields{
+		"requested": len(ticketIds),
+		"requeued":  len(tickets),
+	}).Debug("requeued tickets")
+
+	return resp, tickets, nil
+}
+
+// sweepExpiredTickets publishes the events of the tickets which expired in the
+// state storage every interval, until ctx is done.
+func sweepExpiredTickets(ctx context.Context, c clock.Clock, interval time.Duration, store statestore.Service, publisher *events.Publisher) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.After(interval):
+		}
+		publishExpiredTickets(ctx, store, publisher)
+	}
+}
+
+// publishExpiredTickets publishes an EXPIRED event for the tickets whose pending
+// release timed out since the last call, which returns them to the pool, and a
+// DELETED event for the tickets removed when their time to live elapsed.
+func publishExpiredTickets(ctx context.Context, store statestore.Service, publisher *events.Publisher) {
+	ids, err := store.CleanupPendingRelease(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to clean up expired pending releases")
+	} else {
+		publisher.PublishIDs(pb.TicketEvent_EXPIRED, ids...)
+	}
+
+	ids, err = store.CleanupExpiredTickets(ctx)
+	if err != nil {
+		logger.WithError(err).Error("failed to clean up expired tickets")
+		return
+	}
+	publisher.PublishIDs(pb.TicketEvent_DELETED, ids...)
+}
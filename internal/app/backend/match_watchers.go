@@ -0,0 +1,213 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opencensus.io/stats"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/internal/statestore"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	configNameWatchMatchesBufferSize = "watchMatchesBufferSize"
+	defaultWatchMatchesBufferSize    = 100
+	watchMatchesRetryInterval        = time.Second
+)
+
+// matchWatchers publishes the matches returned by FetchMatches calls of this
+// backend instance, and fans out the matches published by all instances to
+// the WatchMatches streams of this instance.
+type matchWatchers struct {
+	store      statestore.Service
+	bufferSize int
+	outgoing   chan *pb.Match
+
+	mu       sync.Mutex
+	watchers map[*matchWatcher]struct{}
+}
+
+// matchWatcher is the state of a single WatchMatches stream.
+type matchWatcher struct {
+	profiles  map[string]struct{}
+	functions map[string]struct{}
+	matches   chan *pb.Match
+	// evicted is closed when the watcher fell behind and was removed.
+	evicted chan struct{}
+}
+
+func newMatchWatchers(cfg config.View, store statestore.Service) *matchWatchers {
+	bufferSize := defaultWatchMatchesBufferSize
+	if cfg.IsSet(configNameWatchMatchesBufferSize) {
+		bufferSize = cfg.GetInt(configNameWatchMatchesBufferSize)
+	}
+
+	return &matchWatchers{
+		store:      store,
+		bufferSize: bufferSize,
+		outgoing:   make(chan *pb.Match, bufferSize),
+		watchers:   make(map[*matchWatcher]struct{}),
+	}
+}
+
+// run publishes and receives matches until the context is canceled.
+func (mw *matchWatchers) run(ctx context.Context) {
+	go mw.publishLoop(ctx)
+
+	for {
+		err := mw.store.WatchMatches(ctx, func(m *pb.Match) error {
+			mw.broadcast(m)
+			return nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		logger.WithError(err).Error("lost the subscription to matches, resubscribing")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchMatchesRetryInterval):
+		}
+	}
+}
+
+func (mw *matchWatchers) publishLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case m := <-mw.outgoing:
+			err := mw.store.PublishMatch(ctx, m)
+			if err != nil {
+				logger.WithError(err).Errorf("failed to publish match %s to watchers", m.GetMatchId())
+			}
+		}
+	}
+}
+
+// publish queues the match for delivery to the watchers of all backend
+// instances. It never blocks FetchMatches: matches are dropped when the
+// queue is full.
+func (mw *matchWatchers) publish(m *pb.Match) {
+	select {
+	case mw.outgoing <- m:
+	default:
+		logger.Warningf("too many matches queued for watchers, dropping match %s", m.GetMatchId())
+	}
+}
+
+func (mw *matchWatchers) add(req *pb.WatchMatchesRequest) *matchWatcher {
+	w := &matchWatcher{
+		profiles:  stringSet(req.GetProfileNames()),
+		functions: stringSet(req.GetMatchFunctions()),
+		matches:   make(chan *pb.Match, mw.bufferSize),
+		evicted:   make(chan struct{}),
+	}
+
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+	mw.watchers[w] = struct{}{}
+	return w
+}
+
+func (mw *matchWatchers) remove(w *matchWatcher) {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+	delete(mw.watchers, w)
+}
+
+// broadcast delivers the match to all interested watchers, evicting the
+// watchers whose buffer is full.
+func (mw *matchWatchers) broadcast(m *pb.Match) {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	for w := range mw.watchers {
+		if !w.wants(m) {
+			continue
+		}
+
+		select {
+		case w.matches <- m:
+		default:
+			delete(mw.watchers, w)
+			close(w.evicted)
+		}
+	}
+}
+
+func (w *matchWatcher) wants(m *pb.Match) bool {
+	if len(w.profiles) > 0 {
+		if _, ok := w.profiles[m.GetMatchProfile()]; !ok {
+			return false
+		}
+	}
+	if len(w.functions) > 0 {
+		if _, ok := w.functions[m.GetMatchFunction()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// WatchMatches streams every Match returned by FetchMatches calls on any Backend
+// instance from the time the stream is opened, optionally filtered by profile or
+// MatchFunction. Streams falling too far behind are closed with RESOURCE_EXHAUSTED.
+func (s *backendService) WatchMatches(req *pb.WatchMatchesRequest, stream pb.BackendService_WatchMatchesServer) error {
+	ctx := stream.Context()
+	w := s.watchers.add(req)
+	defer s.watchers.remove(w)
+
+	// Sending the headers signals the caller that the stream is registered and
+	// will receive all matches from now on.
+	err := stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.Errorf(codes.Canceled, "%v", ctx.Err())
+		case <-rpc.Draining(ctx):
+			return rpc.DrainingError()
+		case <-w.evicted:
+			stats.Record(ctx, watchMatchesEvicted.M(1))
+			return status.Errorf(codes.ResourceExhausted, "stream fell behind by more than %d matches and was closed", s.watchers.bufferSize)
+		case m := <-w.matches:
+			err = stream.Send(&pb.WatchMatchesResponse{Match: m})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func stringSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
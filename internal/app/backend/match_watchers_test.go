@@ -0,0 +1,94 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestMatchWatchersFilter(t *testing.T) {
+	require := require.New(t)
+
+	mw := newMatchWatchers(viper.New(), nil)
+	all := mw.add(&pb.WatchMatchesRequest{})
+	byProfile := mw.add(&pb.WatchMatchesRequest{ProfileNames: []string{"p1"}})
+	byFunction := mw.add(&pb.WatchMatchesRequest{MatchFunctions: []string{"f2"}})
+	byBoth := mw.add(&pb.WatchMatchesRequest{ProfileNames: []string{"p1", "p2"}, MatchFunctions: []string{"f1"}})
+
+	m1 := &pb.Match{MatchId: "1", MatchProfile: "p1", MatchFunction: "f1"}
+	m2 := &pb.Match{MatchId: "2", MatchProfile: "p2", MatchFunction: "f2"}
+	mw.broadcast(m1)
+	mw.broadcast(m2)
+
+	received := func(w *matchWatcher) []string {
+		ids := []string{}
+		for len(w.matches) > 0 {
+			ids = append(ids, (<-w.matches).GetMatchId())
+		}
+		return ids
+	}
+	require.Equal([]string{"1", "2"}, received(all))
+	require.Equal([]string{"1"}, received(byProfile))
+	require.Equal([]string{"2"}, received(byFunction))
+	require.Equal([]string{"1"}, received(byBoth))
+
+	mw.remove(all)
+	mw.broadcast(m1)
+	require.Empty(received(all))
+}
+
+func TestMatchWatchersEvictSlowWatcher(t *testing.T) {
+	require := require.New(t)
+
+	cfg := viper.New()
+	cfg.Set(configNameWatchMatchesBufferSize, 2)
+	mw := newMatchWatchers(cfg, nil)
+
+	slow := mw.add(&pb.WatchMatchesRequest{})
+	fast := mw.add(&pb.WatchMatchesRequest{})
+
+	for i := 0; i < 2; i++ {
+		mw.broadcast(&pb.Match{})
+		<-fast.matches
+	}
+	select {
+	case <-slow.evicted:
+		require.Fail("watcher evicted before its buffer is full")
+	default:
+	}
+
+	mw.broadcast(&pb.Match{})
+	<-slow.evicted
+	require.Len(fast.matches, 1)
+
+	// Removing an evicted watcher is a no-op.
+	mw.remove(slow)
+	require.Len(mw.watchers, 1)
+}
+
+func TestMatchWatchersPublishDoesNotBlock(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set(configNameWatchMatchesBufferSize, 1)
+	mw := newMatchWatchers(cfg, nil)
+
+	// Nothing consumes the queue, the second match is dropped.
+	mw.publish(&pb.Match{MatchId: "1"})
+	mw.publish(&pb.Match{MatchId: "2"})
+	require.Equal(t, "1", (<-mw.outgoing).GetMatchId())
+}
@@ -0,0 +1,350 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/internal/telemetry"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	configNameMmfMaxAttempts         = "mmf.maxAttempts"
+	configNameMmfFailureThreshold    = "mmf.circuitBreaker.failureThreshold"
+	configNameMmfOpenDuration        = "mmf.circuitBreaker.openDuration"
+	configNameMmfHealthCheckInterval = "mmf.healthCheckInterval"
+	configNameMmfEndpointIdleTimeout = "mmf.endpointIdleTimeout"
+
+	defaultMmfMaxAttempts         = 3
+	defaultMmfFailureThreshold    = 5
+	defaultMmfOpenDuration        = 10 * time.Second
+	defaultMmfHealthCheckInterval = 10 * time.Second
+	defaultMmfEndpointIdleTimeout = 5 * time.Minute
+
+	mmfHealthCheckTimeout = 2 * time.Second
+)
+
+// mmfEndpoint is a replica of a match function.
+type mmfEndpoint struct {
+	address  string
+	funcType pb.FunctionConfig_Type
+}
+
+// mmfEndpoints load balances the calls to match functions between their
+// endpoints. Each endpoint has a circuit breaker: it is skipped for
+// openDuration after failureThreshold consecutive failures or a failed health
+// check, then a single trial call decides whether it is used again.
+type mmfEndpoints struct {
+	cc         *rpc.ClientCache
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+	now        func() time.Time
+
+	maxAttempts         int
+	failureThreshold    int
+	openDuration        time.Duration
+	healthCheckInterval time.Duration
+	idleTimeout         time.Duration
+
+	mu        sync.Mutex
+	endpoints map[mmfEndpoint]*endpointState
+	next      int
+}
+
+type endpointState struct {
+	consecutiveFailures int
+	// openUntil is set while the circuit breaker is open.
+	openUntil time.Time
+	// trial is true while the call deciding whether to close the breaker is in flight.
+	trial    bool
+	lastUsed time.Time
+}
+
+func newMmfEndpoints(cfg config.View, cc *rpc.ClientCache) *mmfEndpoints {
+	e := &mmfEndpoints{
+		cc:                  cc,
+		lookupHost:          net.DefaultResolver.LookupHost,
+		now:                 time.Now,
+		maxAttempts:         defaultMmfMaxAttempts,
+		failureThreshold:    defaultMmfFailureThreshold,
+		openDuration:        defaultMmfOpenDuration,
+		healthCheckInterval: defaultMmfHealthCheckInterval,
+		idleTimeout:         defaultMmfEndpointIdleTimeout,
+		endpoints:           make(map[mmfEndpoint]*endpointState),
+	}
+
+	if cfg.IsSet(configNameMmfMaxAttempts) {
+		e.maxAttempts = cfg.GetInt(configNameMmfMaxAttempts)
+	}
+	if cfg.IsSet(configNameMmfFailureThreshold) {
+		e.failureThreshold = cfg.GetInt(configNameMmfFailureThreshold)
+	}
+	if cfg.IsSet(configNameMmfOpenDuration) {
+		e.openDuration = cfg.GetDuration(configNameMmfOpenDuration)
+	}
+	if cfg.IsSet(configNameMmfHealthCheckInterval) {
+		e.healthCheckInterval = cfg.GetDuration(configNameMmfHealthCheckInterval)
+	}
+	if cfg.IsSet(configNameMmfEndpointIdleTimeout) {
+		e.idleTimeout = cfg.GetDuration(configNameMmfEndpointIdleTimeout)
+	}
+	return e
+}
+
+// functionName identifies the match function in the metrics.
+func functionName(fc *pb.FunctionConfig) string {
+	return net.JoinHostPort(fc.GetHost(), strconv.Itoa(int(fc.GetPort())))
+}
+
+// resolve returns the endpoints of the match function.
+func (e *mmfEndpoints) resolve(ctx context.Context, fc *pb.FunctionConfig) ([]mmfEndpoint, error) {
+	port := strconv.Itoa(int(fc.GetPort()))
+	addresses := []string{net.JoinHostPort(fc.GetHost(), port)}
+	if fc.GetResolveHost() {
+		hosts, err := e.lookupHost(ctx, fc.GetHost())
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to resolve match function host %s: %v", fc.GetHost(), err)
+		}
+		addresses = addresses[:0]
+		for _, h := range hosts {
+			addresses = append(addresses, net.JoinHostPort(h, port))
+		}
+	}
+	addresses = append(addresses, fc.GetEndpoints()...)
+
+	seen := make(map[string]struct{}, len(addresses))
+	endpoints := make([]mmfEndpoint, 0, len(addresses))
+	for _, a := range addresses {
+		if _, ok := seen[a]; ok {
+			continue
+		}
+		seen[a] = struct{}{}
+		endpoints = append(endpoints, mmfEndpoint{address: a, funcType: fc.GetType()})
+	}
+	if len(endpoints) == 0 {
+		return nil, status.Errorf(codes.Unavailable, "match function host %s has no address", fc.GetHost())
+	}
+	return endpoints, nil
+}
+
+// pick returns the next endpoint in round robin order whose circuit breaker
+// lets calls through, skipping the endpoints already tried.
+func (e *mmfEndpoints) pick(candidates []mmfEndpoint, tried map[mmfEndpoint]bool) (mmfEndpoint, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	start := e.next
+	e.next++
+	for i := range candidates {
+		ep := candidates[(start+i)%len(candidates)]
+		if tried[ep] {
+			continue
+		}
+
+		s := e.state(ep, now)
+		switch {
+		case s.openUntil.IsZero():
+		case now.Before(s.openUntil) || s.trial:
+			continue
+		default:
+			// Half open: let a single call through.
+			s.trial = true
+		}
+		return ep, nil
+	}
+	return mmfEndpoint{}, status.Errorf(codes.Unavailable, "all %d match function endpoints are unavailable", len(candidates))
+}
+
+func (e *mmfEndpoints) state(ep mmfEndpoint, now time.Time) *endpointState {
+	s, ok := e.endpoints[ep]
+	if !ok {
+		s = &endpointState{}
+		e.endpoints[ep] = s
+	}
+	s.lastUsed = now
+	return s
+}
+
+// report updates the circuit breaker of the endpoint with the result of a call.
+func (e *mmfEndpoints) report(ctx context.Context, ep mmfEndpoint, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	s, ok := e.endpoints[ep]
+	if !ok {
+		return
+	}
+	wasTrial := s.trial
+	s.trial = false
+
+	if err == nil {
+		s.consecutiveFailures = 0
+		s.openUntil = time.Time{}
+		return
+	}
+	if !isEndpointFailure(ctx, err) {
+		return
+	}
+
+	s.consecutiveFailures++
+	if wasTrial || s.consecutiveFailures >= e.failureThreshold {
+		e.open(ep, s, err)
+	}
+}
+
+// open makes calls skip the endpoint for openDuration, and drops its possibly
+// broken connections once the calls in flight are done.
+func (e *mmfEndpoints) open(ep mmfEndpoint, s *endpointState, err error) {
+	if s.openUntil.IsZero() {
+		logger.WithFields(logrus.Fields{
+			"error":   err.Error(),
+			"address": ep.address,
+		}).Warning("match function endpoint is unavailable, skipping it")
+	}
+	s.openUntil = e.now().Add(e.openDuration)
+	e.cc.Evict(ep.address)
+}
+
+// isEndpointFailure returns true if the error is caused by the endpoint rather
+// than by the request or the caller.
+func isEndpointFailure(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	switch status.Code(errors.Cause(err)) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// run health checks the endpoints and forgets the idle ones until the
+// context is canceled.
+func (e *mmfEndpoints) run(ctx context.Context) {
+	ticker := time.NewTicker(e.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.checkEndpoints(ctx)
+		}
+	}
+}
+
+func (e *mmfEndpoints) checkEndpoints(ctx context.Context) {
+	e.mu.Lock()
+	now := e.now()
+	toCheck := []mmfEndpoint{}
+	for ep, s := range e.endpoints {
+		if now.Sub(s.lastUsed) >= e.idleTimeout {
+			delete(e.endpoints, ep)
+			continue
+		}
+		// Open breakers get a trial call once their open duration is over.
+		if s.openUntil.IsZero() {
+			toCheck = append(toCheck, ep)
+		}
+	}
+	e.mu.Unlock()
+	// The clients of the forgotten endpoints are closed once idle.
+	e.cc.EvictIdle(e.idleTimeout)
+
+	var wg sync.WaitGroup
+	for _, ep := range toCheck {
+		wg.Add(1)
+		go func(ep mmfEndpoint) {
+			defer wg.Done()
+			err := e.healthCheck(ctx, ep)
+			if err == nil || ctx.Err() != nil {
+				return
+			}
+
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			if s, ok := e.endpoints[ep]; ok {
+				e.open(ep, s, err)
+			}
+		}(ep)
+	}
+	wg.Wait()
+}
+
+// healthCheck uses the gRPC health service or the /healthz HTTP endpoint of
+// the match function. Match functions which don't implement them are
+// considered healthy.
+func (e *mmfEndpoints) healthCheck(ctx context.Context, ep mmfEndpoint) error {
+	ctx, cancel := context.WithTimeout(ctx, mmfHealthCheckTimeout)
+	defer cancel()
+
+	switch ep.funcType {
+	case pb.FunctionConfig_GRPC:
+		conn, release, err := e.cc.GetGRPC(ep.address)
+		if err != nil {
+			return err
+		}
+		defer release()
+		resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+		if status.Code(err) == codes.Unimplemented {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+			return status.Errorf(codes.Unavailable, "match function is %s", resp.GetStatus())
+		}
+		return nil
+	case pb.FunctionConfig_REST:
+		client, baseURL, release, err := e.cc.GetHTTP(ep.address)
+		if err != nil {
+			return err
+		}
+		defer release()
+		req, err := http.NewRequest(http.MethodGet, baseURL+telemetry.HealthCheckEndpoint, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "match function health check failed: %v", err)
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		if resp.StatusCode == http.StatusNotFound || resp.StatusCode < 300 {
+			return nil
+		}
+		return status.Errorf(codes.Unavailable, "match function health check responded with status %d", resp.StatusCode)
+	}
+	return fmt.Errorf("unsupported match function type %s", ep.funcType)
+}
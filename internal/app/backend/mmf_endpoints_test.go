@@ -0,0 +1,197 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func newTestMmfEndpoints(now *time.Time) *mmfEndpoints {
+	cfg := viper.New()
+	cfg.Set(configNameMmfFailureThreshold, 2)
+	cfg.Set(configNameMmfOpenDuration, "10s")
+	e := newMmfEndpoints(cfg, rpc.NewClientCache(cfg))
+	e.now = func() time.Time { return *now }
+	return e
+}
+
+func TestMmfEndpointsResolve(t *testing.T) {
+	now := time.Now()
+	e := newTestMmfEndpoints(&now)
+	e.lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		require.Equal(t, "mmf.default.svc", host)
+		return []string{"10.0.0.1", "10.0.0.2"}, nil
+	}
+	ctx := context.Background()
+
+	endpoints, err := e.resolve(ctx, &pb.FunctionConfig{
+		Host:      "mmf",
+		Port:      50502,
+		Type:      pb.FunctionConfig_GRPC,
+		Endpoints: []string{"mmf-2:50502", "mmf:50502"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []mmfEndpoint{
+		{address: "mmf:50502", funcType: pb.FunctionConfig_GRPC},
+		{address: "mmf-2:50502", funcType: pb.FunctionConfig_GRPC},
+	}, endpoints)
+
+	endpoints, err = e.resolve(ctx, &pb.FunctionConfig{
+		Host:        "mmf.default.svc",
+		Port:        50502,
+		Type:        pb.FunctionConfig_REST,
+		ResolveHost: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []mmfEndpoint{
+		{address: "10.0.0.1:50502", funcType: pb.FunctionConfig_REST},
+		{address: "10.0.0.2:50502", funcType: pb.FunctionConfig_REST},
+	}, endpoints)
+}
+
+func TestMmfEndpointsCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	e := newTestMmfEndpoints(&now)
+	ctx := context.Background()
+	a := mmfEndpoint{address: "a:1", funcType: pb.FunctionConfig_GRPC}
+	b := mmfEndpoint{address: "b:1", funcType: pb.FunctionConfig_GRPC}
+	candidates := []mmfEndpoint{a, b}
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	pick := func() mmfEndpoint {
+		ep, err := e.pick(candidates, nil)
+		require.NoError(t, err)
+		return ep
+	}
+
+	// Calls are spread between the endpoints.
+	require.Equal(t, a, pick())
+	require.Equal(t, b, pick())
+
+	// Errors of the request don't count as failures of the endpoint.
+	e.report(ctx, a, status.Error(codes.InvalidArgument, "bad profile"))
+	e.report(ctx, a, status.Error(codes.InvalidArgument, "bad profile"))
+	require.Equal(t, a, pick())
+
+	e.report(ctx, a, unavailable)
+	require.Equal(t, b, pick())
+	e.report(ctx, a, unavailable)
+
+	// a is skipped while its breaker is open.
+	require.Equal(t, b, pick())
+	require.Equal(t, b, pick())
+
+	_, err := e.pick(candidates, map[mmfEndpoint]bool{b: true})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	// Once the open duration is over, a single trial call goes through.
+	now = now.Add(10 * time.Second)
+	require.Equal(t, b, pick())
+	require.Equal(t, a, pick())
+	require.Equal(t, b, pick())
+	require.Equal(t, b, pick())
+
+	// A failed trial opens the breaker again.
+	e.report(ctx, a, unavailable)
+	require.Equal(t, b, pick())
+	require.Equal(t, b, pick())
+
+	// A successful trial closes it.
+	now = now.Add(10 * time.Second)
+	require.Equal(t, b, pick())
+	require.Equal(t, a, pick())
+	e.report(ctx, a, nil)
+	require.Equal(t, b, pick())
+	require.Equal(t, a, pick())
+}
+
+func newRestMmf(t *testing.T, calls *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		m := jsonpb.Marshaler{}
+		result, err := m.MarshalToString(&pb.RunResponse{Proposal: &pb.Match{MatchId: "1"}})
+		require.NoError(t, err)
+		fmt.Fprintf(w, `{"result": %s}`, result)
+	}))
+}
+
+func TestCallMmfRetry(t *testing.T) {
+	var calls int
+	server := newRestMmf(t, &calls)
+	defer server.Close()
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	// The first endpoint doesn't accept connections.
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	downURL, err := url.Parse(down.URL)
+	require.NoError(t, err)
+
+	now := time.Now()
+	e := newTestMmfEndpoints(&now)
+	req := &pb.FetchMatchesRequest{
+		Config: &pb.FunctionConfig{
+			Host:      downURL.Hostname(),
+			Port:      int32(mustAtoi(t, downURL.Port())),
+			Type:      pb.FunctionConfig_REST,
+			Endpoints: []string{u.Host},
+		},
+		Profile: &pb.MatchProfile{Name: "test"},
+	}
+
+	call := func() ([]*pb.Match, error) {
+		// Start the round robin with the endpoint which is down.
+		e.next = 0
+		proposals := make(chan *pb.Match, 10)
+		err := callMmf(context.Background(), e, req, proposals)
+		matches := []*pb.Match{}
+		for m := range proposals {
+			matches = append(matches, m)
+		}
+		return matches, err
+	}
+
+	_, err = call()
+	require.Equal(t, codes.Internal, status.Code(err))
+	require.Equal(t, 0, calls)
+
+	req.Config.Retry = true
+	matches, err := call()
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Equal(t, 1, calls)
+}
+
+func mustAtoi(t *testing.T, s string) int {
+	i, err := strconv.Atoi(s)
+	require.NoError(t, err)
+	return i
+}
@@ -6,6 +6,7 @@ import (
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/ipb"
 	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/pkg/pb"
 )
 
 type synchronizerClient struct {
@@ -47,3 +48,11 @@ func (sc *synchronizerClient) synchronize(ctx context.Context) (synchronizerStre
 	}
 	return client.(ipb.SynchronizerClient).Synchronize(ctx)
 }
+
+func (sc *synchronizerClient) status(ctx context.Context) (*pb.GetSynchronizerStatusResponse, error) {
+	client, err := sc.cacher.Get()
+	if err != nil {
+		return nil, err
+	}
+	return client.(ipb.SynchronizerClient).GetStatus(ctx, &pb.GetSynchronizerStatusRequest{})
+}
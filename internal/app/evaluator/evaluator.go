@@ -12,7 +12,9 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package evaluator provides the Evaluator service for Open Match golang harness.
+// Package evaluator provides the Evaluator service for Open Match golang
+// harness. Evaluators outside of Open Match use
+// open-match.dev/open-match/pkg/evaluator.
 package evaluator
 
 import (
@@ -27,6 +29,8 @@ import (
 var (
 	matchesPerEvaluateRequest  = stats.Int64("open-match.dev/evaluator/matches_per_request", "Number of matches sent to the evaluator per request", stats.UnitDimensionless)
 	matchesPerEvaluateResponse = stats.Int64("open-match.dev/evaluator/matches_per_response", "Number of matches returned by the evaluator per response", stats.UnitDimensionless)
+	// CollidedMatchesPerEvaluate is recorded by the default decollider.
+	CollidedMatchesPerEvaluate = stats.Int64("open-match.dev/defaulteval/collided_matches_per_call", "Number of collided matches per default evaluator call", stats.UnitDimensionless)
 
 	matchesPerEvaluateRequestView = &view.View{
 		Measure:     matchesPerEvaluateRequest,
@@ -40,17 +44,24 @@ var (
 		Description: "Number of matches sent to the evaluator per response",
 		Aggregation: telemetry.DefaultCountDistribution,
 	}
+	collidedMatchesPerEvaluateView = &view.View{
+		Measure:     CollidedMatchesPerEvaluate,
+		Name:        "open-match.dev/defaulteval/collided_matches_per_call",
+		Description: "Number of collided matches per default evaluator call",
+		Aggregation: view.Sum(),
+	}
 )
 
 // BindServiceFor creates the evaluator service and binds it to the serving harness.
 func BindServiceFor(eval Evaluator) appmain.Bind {
 	return func(p *appmain.Params, b *appmain.Bindings) error {
 		b.AddHandleFunc(func(s *grpc.Server) {
-			pb.RegisterEvaluatorServer(s, &evaluatorService{eval})
+			pb.RegisterEvaluatorServer(s, NewServer(eval))
 		}, pb.RegisterEvaluatorHandlerFromEndpoint)
 		b.RegisterViews(
 			matchesPerEvaluateRequestView,
 			matchesPerEvaluateResponseView,
+			collidedMatchesPerEvaluateView,
 		)
 		return nil
 	}
@@ -12,7 +12,6 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package evaluator provides the Evaluator service for Open Match golang harness.
 package evaluator
 
 import (
@@ -25,9 +24,8 @@ import (
 	"open-match.dev/open-match/pkg/pb"
 )
 
-// Evaluator is the function signature for the Evaluator to be implemented by
-// the user. The harness will pass the Matches to evaluate to the Evaluator
-// and the Evaluator will return an accepted list of Matches.
+// Evaluator is called by the harness for each Evaluate call, see
+// open-match.dev/open-match/pkg/evaluator.Evaluator.
 type Evaluator func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error
 
 // evaluatorService implements pb.EvaluatorServer, the server generated by
@@ -36,6 +34,13 @@ type evaluatorService struct {
 	evaluate Evaluator
 }
 
+// NewServer returns a pb.EvaluatorServer streaming the matches of each
+// Evaluate call to eval, and the IDs of the matches it accepts back to the
+// synchronizer.
+func NewServer(eval Evaluator) pb.EvaluatorServer {
+	return &evaluatorService{eval}
+}
+
 // Evaluate is this harness's implementation of the gRPC call defined in
 // api/evaluator.proto.
 func (s *evaluatorService) Evaluate(stream pb.Evaluator_EvaluateServer) error {
@@ -0,0 +1,94 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluator
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+type fakeEvaluateStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	reqs []*pb.EvaluateRequest
+	sent []string
+}
+
+func (s *fakeEvaluateStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeEvaluateStream) Recv() (*pb.EvaluateRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *fakeEvaluateStream) Send(resp *pb.EvaluateResponse) error {
+	s.sent = append(s.sent, resp.GetMatchId())
+	return nil
+}
+
+func TestServerEvaluate(t *testing.T) {
+	stream := &fakeEvaluateStream{
+		ctx: context.Background(),
+		reqs: []*pb.EvaluateRequest{
+			{Match: &pb.Match{MatchId: "1"}},
+			{Match: &pb.Match{MatchId: "2"}},
+			{Match: &pb.Match{MatchId: "3"}},
+		},
+	}
+
+	// Accepts every other match.
+	eval := func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+		i := 0
+		for m := range in {
+			if i%2 == 0 {
+				out <- m.GetMatchId()
+			}
+			i++
+		}
+		return nil
+	}
+
+	require.NoError(t, NewServer(eval).Evaluate(stream))
+	require.Equal(t, []string{"1", "3"}, stream.sent)
+}
+
+func TestServerEvaluateError(t *testing.T) {
+	stream := &fakeEvaluateStream{
+		ctx:  context.Background(),
+		reqs: []*pb.EvaluateRequest{{Match: &pb.Match{MatchId: "1"}}},
+	}
+
+	errEval := errors.New("evaluation failed")
+	eval := func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+		return errEval
+	}
+
+	err := NewServer(eval).Evaluate(stream)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), errEval.Error())
+	require.Empty(t, stream.sent)
+}
@@ -19,6 +19,8 @@ import (
 	"go.opencensus.io/stats/view"
 	"google.golang.org/grpc"
 	"open-match.dev/open-match/internal/appmain"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/events"
 	"open-match.dev/open-match/internal/statestore"
 	"open-match.dev/open-match/internal/telemetry"
 	"open-match.dev/open-match/pkg/pb"
@@ -58,10 +60,23 @@ var (
 
 // BindService creates the frontend service and binds it to the serving harness.
 func BindService(p *appmain.Params, b *appmain.Bindings) error {
+	publisher, err := events.BindPublisher(p, b)
+	if err != nil {
+		return err
+	}
+
 	service := &frontendService{
-		cfg:   p.Config(),
-		store: statestore.New(p.Config()),
+		cfg:       p.Config(),
+		store:     statestore.NewWithClock(p.Config(), p.Clock()),
+		publisher: publisher,
+	}
+
+	// The schema is built again when the configuration is reloaded.
+	unsubscribe, err := config.Subscribe(p.Config(), parseSchema, service.setSchema)
+	if err != nil {
+		return err
 	}
+	b.AddCloser(unsubscribe)
 
 	b.AddHealthCheckFunc(service.store.HealthCheck)
 	b.AddHandleFunc(func(s *grpc.Server) {
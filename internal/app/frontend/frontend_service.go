@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
@@ -26,7 +27,10 @@ import (
 	"go.opencensus.io/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/auth"
 	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/events"
+	"open-match.dev/open-match/internal/rpc"
 	"open-match.dev/open-match/internal/statestore"
 	"open-match.dev/open-match/pkg/pb"
 )
@@ -34,8 +38,22 @@ import (
 // frontendService implements the Frontend service that is used to create
 // Tickets and add, remove them from the pool for matchmaking.
 type frontendService struct {
-	cfg   config.View
-	store statestore.Service
+	cfg       config.View
+	store     statestore.Service
+	publisher *events.Publisher
+	// schema of the active configuration, set by setSchema.
+	schema atomic.Value // *schema
+}
+
+// currentSchema returns nil if the schema is disabled.
+func (s *frontendService) currentSchema() *schema {
+	sch, _ := s.schema.Load().(*schema)
+	return sch
+}
+
+// setSchema applies the schema returned by parseSchema.
+func (s *frontendService) setSchema(v interface{}) {
+	s.schema.Store(v.(*schema))
 }
 
 var (
@@ -49,6 +67,7 @@ var (
 // A ticket is considered as ready for matchmaking once it is created.
 //   - If a TicketId exists in a Ticket request, an auto-generated TicketId will override this field.
 //   - If SearchFields exist in a Ticket, CreateTicket will also index these fields such that one can query the ticket with query.QueryTickets function.
+//   - If authentication is enabled, the caller is recorded as the owner of the Ticket.
 func (s *frontendService) CreateTicket(ctx context.Context, req *pb.CreateTicketRequest) (*pb.Ticket, error) {
 	// Perform input validation.
 	if req.Ticket == nil {
@@ -60,8 +79,20 @@ func (s *frontendService) CreateTicket(ctx context.Context, req *pb.CreateTicket
 	if req.Ticket.CreateTime != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "tickets cannot be created with create time set")
 	}
+	if req.Ticket.Owner != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tickets cannot be created with an owner")
+	}
+	if err := s.currentSchema().validateTicket(req.Ticket, proto.Size(req.Ticket)); err != nil {
+		return nil, err
+	}
+
+	ticket, err := doCreateTicket(ctx, req, s.store)
+	if err != nil {
+		return nil, err
+	}
 
-	return doCreateTicket(ctx, req, s.store)
+	s.publisher.Publish(pb.TicketEvent_CREATED, ticket)
+	return ticket, nil
 }
 
 func doCreateTicket(ctx context.Context, req *pb.CreateTicketRequest, store statestore.Service) (*pb.Ticket, error) {
@@ -73,6 +104,10 @@ func doCreateTicket(ctx context.Context, req *pb.CreateTicketRequest, store stat
 
 	ticket.Id = xid.New().String()
 	ticket.CreateTime = ptypes.TimestampNow()
+	ticket.Generation = 1
+	if id, ok := auth.FromContext(ctx); ok {
+		ticket.Owner = id.Subject
+	}
 
 	sfCount := 0
 	sfCount += len(ticket.GetSearchFields().GetDoubleArgs())
@@ -94,6 +129,89 @@ func doCreateTicket(ctx context.Context, req *pb.CreateTicketRequest, store stat
 	return ticket, nil
 }
 
+// UpdateTicket updates the SearchFields and Extensions of an indexed Ticket.
+// The input Ticket generation must match the stored one, it gets incremented on every update.
+// Tickets which are pending release or already assigned can not be updated.
+func (s *frontendService) UpdateTicket(ctx context.Context, req *pb.UpdateTicketRequest) (*pb.Ticket, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "request is nil")
+	}
+	if req.Ticket == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ".ticket is required")
+	}
+	if req.Ticket.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, ".ticket.id is required")
+	}
+	if req.Ticket.Assignment != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tickets cannot be updated with an assignment")
+	}
+	if err := s.currentSchema().validateTicket(req.Ticket, proto.Size(req.Ticket)); err != nil {
+		return nil, err
+	}
+
+	ticket, err := doUpdateTicket(ctx, req, s.store)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publisher.Publish(pb.TicketEvent_UPDATED, ticket)
+	return ticket, nil
+}
+
+func doUpdateTicket(ctx context.Context, req *pb.UpdateTicketRequest, store statestore.Service) (*pb.Ticket, error) {
+	ticket, ok := proto.Clone(req.Ticket).(*pb.Ticket)
+	if !ok {
+		return nil, status.Error(codes.Internal, "failed to clone input ticket proto")
+	}
+
+	m := store.NewMutex(ticket.Id)
+	err := m.Lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if _, err = m.Unlock(ctx); err != nil {
+			logger.WithError(err).Error("error on mutex unlock")
+		}
+	}()
+
+	stored, err := store.GetTicket(ctx, ticket.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err = auth.CheckTicketOwner(ctx, stored); err != nil {
+		return nil, err
+	}
+	if stored.Assignment != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "can not update an assigned ticket, id: %s", ticket.Id)
+	}
+	if stored.Generation != ticket.Generation {
+		return nil, status.Errorf(codes.Aborted, "ticket generation mismatch, id: %s, expected: %d, got: %d", ticket.Id, stored.Generation, ticket.Generation)
+	}
+
+	stored.SearchFields = ticket.SearchFields
+	stored.Extensions = ticket.Extensions
+	stored.Generation++
+
+	sfCount := 0
+	sfCount += len(stored.GetSearchFields().GetDoubleArgs())
+	sfCount += len(stored.GetSearchFields().GetStringArgs())
+	sfCount += len(stored.GetSearchFields().GetTags())
+	stats.Record(ctx, searchFieldsPerTicket.M(int64(sfCount)))
+	stats.Record(ctx, totalBytesPerTicket.M(int64(proto.Size(stored))))
+
+	err = store.UpdateTicket(ctx, stored)
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    stored.Id,
+		}).Error("failed to update the ticket")
+		return nil, err
+	}
+
+	return stored, nil
+}
+
 // CreateBackfill creates a new Backfill object.
 // it assigns an unique Id to the input Backfill and record it in state storage.
 // Set initial LastAcknowledge time for this Backfill.
@@ -110,6 +228,9 @@ func (s *frontendService) CreateBackfill(ctx context.Context, req *pb.CreateBack
 	if req.Backfill.CreateTime != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "backfills cannot be created with create time set")
 	}
+	if err := s.currentSchema().validateBackfill(req.Backfill, proto.Size(req.Backfill)); err != nil {
+		return nil, err
+	}
 
 	return doCreateBackfill(ctx, req, s.store)
 }
@@ -154,6 +275,9 @@ func (s *frontendService) UpdateBackfill(ctx context.Context, req *pb.UpdateBack
 	if req.Backfill == nil {
 		return nil, status.Errorf(codes.InvalidArgument, ".backfill is required")
 	}
+	if err := s.currentSchema().validateBackfill(req.Backfill, proto.Size(req.Backfill)); err != nil {
+		return nil, err
+	}
 
 	backfill, ok := proto.Clone(req.Backfill).(*pb.Backfill)
 	if !ok {
@@ -229,10 +353,17 @@ func (s *frontendService) DeleteBackfill(ctx context.Context, req *pb.DeleteBack
 //   - If SearchFields exist in a Ticket, DeleteTicket will deindex the fields lazily.
 // Users may still be able to assign/get a ticket after calling DeleteTicket on it.
 func (s *frontendService) DeleteTicket(ctx context.Context, req *pb.DeleteTicketRequest) (*empty.Empty, error) {
-	err := doDeleteTicket(ctx, req.GetTicketId(), s.store)
+	err := s.checkTicketOwner(ctx, req.GetTicketId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = doDeleteTicket(ctx, req.GetTicketId(), s.store)
 	if err != nil {
 		return nil, err
 	}
+
+	s.publisher.PublishIDs(pb.TicketEvent_DELETED, req.GetTicketId())
 	return &empty.Empty{}, nil
 }
 
@@ -270,13 +401,52 @@ func doDeleteTicket(ctx context.Context, id string, store statestore.Service) er
 
 // GetTicket get the Ticket associated with the specified TicketId.
 func (s *frontendService) GetTicket(ctx context.Context, req *pb.GetTicketRequest) (*pb.Ticket, error) {
-	return s.store.GetTicket(ctx, req.GetTicketId())
+	ticket, err := s.store.GetTicket(ctx, req.GetTicketId())
+	if err != nil {
+		return nil, err
+	}
+	if err = auth.CheckTicketOwner(ctx, ticket); err != nil {
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// checkTicketOwner returns a PermissionDenied error if the caller is restricted
+// to its own Tickets and does not own the Ticket.
+func (s *frontendService) checkTicketOwner(ctx context.Context, id string) error {
+	if caller, ok := auth.FromContext(ctx); !ok || !caller.OwnTicketsOnly {
+		return nil
+	}
+
+	ticket, err := s.store.GetTicket(ctx, id)
+	if err != nil {
+		return err
+	}
+	return auth.CheckTicketOwner(ctx, ticket)
 }
 
 // WatchAssignments stream back Assignment of the specified TicketId if it is updated.
 //   - If the Assignment is not updated, GetAssignment will retry using the configured backoff strategy.
 func (s *frontendService) WatchAssignments(req *pb.WatchAssignmentsRequest, stream pb.FrontendService_WatchAssignmentsServer) error {
 	ctx := stream.Context()
+	err := s.checkTicketOwner(ctx, req.GetTicketId())
+	if err != nil {
+		return err
+	}
+
+	// The watch is canceled when the server drains, and the client is told to
+	// reconnect to another instance.
+	draining := rpc.Draining(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-draining:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -285,7 +455,13 @@ func (s *frontendService) WatchAssignments(req *pb.WatchAssignmentsRequest, stre
 			sender := func(assignment *pb.Assignment) error {
 				return stream.Send(&pb.WatchAssignmentsResponse{Assignment: assignment})
 			}
-			return doWatchAssignments(ctx, req.GetTicketId(), sender, s.store)
+			err = doWatchAssignments(ctx, req.GetTicketId(), sender, s.store)
+			select {
+			case <-draining:
+				return rpc.DrainingError()
+			default:
+				return err
+			}
 		}
 	}
 }
@@ -315,73 +491,47 @@ func doWatchAssignments(ctx context.Context, id string, sender func(*pb.Assignme
 	return store.GetAssignments(ctx, id, callback)
 }
 
-// AcknowledgeBackfill is used to notify OpenMatch about GameServer connection info.
-// This triggers an assignment process.
-func (s *frontendService) AcknowledgeBackfill(ctx context.Context, req *pb.AcknowledgeBackfillRequest) (*pb.AcknowledgeBackfillResponse, error) {
-	if req.GetBackfillId() == "" {
-		return nil, status.Errorf(codes.InvalidArgument, ".BackfillId is required")
-	}
-	if req.GetAssignment() == nil {
-		return nil, status.Errorf(codes.InvalidArgument, ".Assignment is required")
+// AcknowledgeAssignment records that the client received the Assignment of the specified TicketId.
+//   - The Ticket is kept for another assignedDeleteTimeout after the acknowledgment.
+func (s *frontendService) AcknowledgeAssignment(ctx context.Context, req *pb.AcknowledgeAssignmentRequest) (*pb.Ticket, error) {
+	if req.GetTicketId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, ".ticket_id is required")
 	}
 
-	m := s.store.NewMutex(req.GetBackfillId())
-
-	err := m.Lock(ctx)
+	err := s.checkTicketOwner(ctx, req.GetTicketId())
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		if _, err = m.Unlock(ctx); err != nil {
-			logger.WithError(err).Error("error on mutex unlock")
-		}
-	}()
 
-	bf, associatedTickets, err := s.store.GetBackfill(ctx, req.GetBackfillId())
+	ticket, err := s.store.AcknowledgeAssignment(ctx, req.GetTicketId())
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.store.UpdateAcknowledgmentTimestamp(ctx, req.GetBackfillId())
-	if err != nil {
-		return nil, err
-	}
+	s.publisher.Publish(pb.TicketEvent_ASSIGNMENT_ACKNOWLEDGED, ticket)
+	return ticket, nil
+}
 
-	resp := &pb.AcknowledgeBackfillResponse{
-		Backfill: bf,
-		Tickets:  make([]*pb.Ticket, 0),
+// AcknowledgeBackfill is used to notify OpenMatch about GameServer connection info.
+// This triggers an assignment process.
+func (s *frontendService) AcknowledgeBackfill(ctx context.Context, req *pb.AcknowledgeBackfillRequest) (*pb.AcknowledgeBackfillResponse, error) {
+	if req.GetBackfillId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, ".BackfillId is required")
+	}
+	if req.GetAssignment() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, ".Assignment is required")
 	}
 
-	if len(associatedTickets) != 0 {
-		setResp, tickets, err := s.store.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
-			Assignments: []*pb.AssignmentGroup{{TicketIds: associatedTickets, Assignment: req.GetAssignment()}},
-		})
-		if err != nil {
-			return nil, err
-		}
-
-		resp.Tickets = tickets
-
-		// log errors returned from UpdateAssignments to track tickets with NotFound errors
-		for _, f := range setResp.Failures {
-			logger.Errorf("failed to assign ticket %s, cause %d", f.TicketId, f.Cause)
-		}
-		for _, id := range associatedTickets {
-			err = s.store.DeindexTicket(ctx, id)
-			// Try to deindex all input tickets. Log without returning an error if the deindexing operation failed.
-			if err != nil {
-				logger.WithError(err).Errorf("failed to deindex ticket %s after updating the assignments", id)
-			}
-		}
-
-		// Remove all tickets associated with backfill, because unassigned tickets are not found only
-		err = s.store.UpdateBackfill(ctx, bf, []string{})
-		if err != nil {
-			return nil, err
-		}
+	bf, tickets, err := s.store.AcknowledgeBackfill(ctx, req.GetBackfillId(), req.GetAssignment())
+	if err != nil {
+		return nil, err
 	}
+	s.publisher.Publish(pb.TicketEvent_ASSIGNED, tickets...)
 
-	return resp, nil
+	return &pb.AcknowledgeBackfillResponse{
+		Backfill: bf,
+		Tickets:  tickets,
+	}, nil
 }
 
 // GetBackfill fetches a Backfill object by its ID.
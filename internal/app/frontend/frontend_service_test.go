@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/auth"
 	"open-match.dev/open-match/internal/statestore"
 	statestoreTesting "open-match.dev/open-match/internal/statestore/testing"
 	utilTesting "open-match.dev/open-match/internal/util/testing"
@@ -94,7 +95,7 @@ func TestCreateBackfill(t *testing.T) {
 	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
 	defer closer()
 	ctx := utilTesting.NewContext(t)
-	fs := frontendService{cfg, store}
+	fs := frontendService{cfg: cfg, store: store}
 	var testCases = []struct {
 		description     string
 		request         *pb.CreateBackfillRequest
@@ -157,7 +158,7 @@ func TestCreateBackfill(t *testing.T) {
 	// expect error with canceled context
 	store, closer = statestoreTesting.NewStoreServiceForTesting(t, cfg)
 	defer closer()
-	fs = frontendService{cfg, store}
+	fs = frontendService{cfg: cfg, store: store}
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 
@@ -178,7 +179,7 @@ func TestUpdateBackfill(t *testing.T) {
 	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
 	defer closer()
 	ctx := utilTesting.NewContext(t)
-	fs := frontendService{cfg, store}
+	fs := frontendService{cfg: cfg, store: store}
 	res, err := fs.CreateBackfill(ctx, &pb.CreateBackfillRequest{
 		Backfill: &pb.Backfill{
 			SearchFields: &pb.SearchFields{
@@ -248,7 +249,7 @@ func TestUpdateBackfill(t *testing.T) {
 
 	// expect error with canceled context
 	store, closer = statestoreTesting.NewStoreServiceForTesting(t, cfg)
-	fs = frontendService{cfg, store}
+	fs = frontendService{cfg: cfg, store: store}
 	defer closer()
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
@@ -266,6 +267,128 @@ func TestUpdateBackfill(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestUpdateTicket(t *testing.T) {
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+	fs := frontendService{cfg: cfg, store: store}
+	ticket, err := fs.CreateTicket(ctx, &pb.CreateTicketRequest{
+		Ticket: &pb.Ticket{
+			SearchFields: &pb.SearchFields{
+				StringArgs: map[string]string{
+					"search": "me",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), ticket.Generation)
+
+	assigned, err := fs.CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.NoError(t, err)
+	_, _, err = store.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{assigned.Id},
+				Assignment: &pb.Assignment{Connection: "1.2.3.4:1234"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var testCases = []struct {
+		description     string
+		request         *pb.UpdateTicketRequest
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			description:     "nil request check",
+			request:         nil,
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "request is nil",
+		},
+		{
+			description:     "nil ticket - error is returned",
+			request:         &pb.UpdateTicketRequest{Ticket: nil},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: ".ticket is required",
+		},
+		{
+			description:     "empty ticket, error with no ticket ID",
+			request:         &pb.UpdateTicketRequest{Ticket: &pb.Ticket{}},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: ".ticket.id is required",
+		},
+		{
+			description: "ticket with assignment",
+			request: &pb.UpdateTicketRequest{Ticket: &pb.Ticket{
+				Id:         ticket.Id,
+				Assignment: &pb.Assignment{},
+			}},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: "tickets cannot be updated with an assignment",
+		},
+		{
+			description:     "missing ticket",
+			request:         &pb.UpdateTicketRequest{Ticket: &pb.Ticket{Id: "missing", Generation: 1}},
+			expectedCode:    codes.NotFound,
+			expectedMessage: "Ticket id: missing not found",
+		},
+		{
+			description:     "assigned ticket",
+			request:         &pb.UpdateTicketRequest{Ticket: &pb.Ticket{Id: assigned.Id, Generation: 1}},
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: "can not update an assigned ticket",
+		},
+		{
+			description:     "generation mismatch",
+			request:         &pb.UpdateTicketRequest{Ticket: &pb.Ticket{Id: ticket.Id, Generation: 5}},
+			expectedCode:    codes.Aborted,
+			expectedMessage: "ticket generation mismatch",
+		},
+		{
+			description: "normal ticket",
+			request: &pb.UpdateTicketRequest{Ticket: &pb.Ticket{
+				Id:         ticket.Id,
+				Generation: 1,
+				SearchFields: &pb.SearchFields{
+					DoubleArgs: map[string]float64{
+						"level": 10,
+					},
+				},
+			}},
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.description, func(t *testing.T) {
+			res, err := fs.UpdateTicket(ctx, tc.request)
+			if tc.expectedCode == codes.OK {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, tc.request.Ticket.SearchFields.DoubleArgs, res.SearchFields.DoubleArgs)
+				require.Equal(t, tc.request.Ticket.Generation+1, res.Generation)
+				require.Equal(t, ticket.CreateTime.String(), res.CreateTime.String())
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedCode.String(), status.Convert(err).Code().String())
+				require.Contains(t, status.Convert(err).Message(), tc.expectedMessage)
+			}
+		})
+	}
+
+	// expect error when the ticket is pending release
+	err = store.AddTicketsToPendingRelease(ctx, []string{ticket.Id})
+	require.NoError(t, err)
+	_, err = fs.UpdateTicket(ctx, &pb.UpdateTicketRequest{Ticket: &pb.Ticket{Id: ticket.Id, Generation: 2}})
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition.String(), status.Convert(err).Code().String())
+}
+
 func TestDoWatchAssignments(t *testing.T) {
 	testTicket := &pb.Ticket{
 		Id: "test-id",
@@ -370,7 +493,7 @@ func TestAcknowledgeBackfillValidation(t *testing.T) {
 
 			store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
 			defer closer()
-			fs := frontendService{cfg, store}
+			fs := frontendService{cfg: cfg, store: store}
 			bf, err := fs.AcknowledgeBackfill(ctx, test.request)
 			require.Equal(t, codes.InvalidArgument.String(), status.Convert(err).Code().String())
 			require.Equal(t, test.expectedMessage, status.Convert(err).Message())
@@ -399,7 +522,7 @@ func TestAcknowledgeBackfill(t *testing.T) {
 	}
 	err := store.CreateBackfill(ctx, fakeBackfill, []string{})
 	require.NoError(t, err)
-	fs := frontendService{cfg, store}
+	fs := frontendService{cfg: cfg, store: store}
 
 	resp, err := fs.AcknowledgeBackfill(ctx, &pb.AcknowledgeBackfillRequest{BackfillId: fakeBackfill.Id, Assignment: &pb.Assignment{Connection: "10.0.0.1"}})
 	require.NoError(t, err)
@@ -477,6 +600,116 @@ func TestDoDeleteTicket(t *testing.T) {
 	}
 }
 
+func TestAcknowledgeAssignment(t *testing.T) {
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+	fs := frontendService{cfg: cfg, store: store}
+
+	unassigned, err := fs.CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.NoError(t, err)
+	assigned, err := fs.CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.NoError(t, err)
+	_, _, err = store.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{assigned.Id},
+				Assignment: &pb.Assignment{Connection: "1.2.3.4:1234"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	var testCases = []struct {
+		description     string
+		request         *pb.AcknowledgeAssignmentRequest
+		expectedCode    codes.Code
+		expectedMessage string
+	}{
+		{
+			description:     "no ticket id",
+			request:         &pb.AcknowledgeAssignmentRequest{},
+			expectedCode:    codes.InvalidArgument,
+			expectedMessage: ".ticket_id is required",
+		},
+		{
+			description:     "missing ticket",
+			request:         &pb.AcknowledgeAssignmentRequest{TicketId: "missing"},
+			expectedCode:    codes.NotFound,
+			expectedMessage: "Ticket id: missing not found",
+		},
+		{
+			description:     "unassigned ticket",
+			request:         &pb.AcknowledgeAssignmentRequest{TicketId: unassigned.Id},
+			expectedCode:    codes.FailedPrecondition,
+			expectedMessage: "not assigned",
+		},
+		{
+			description:  "assigned ticket",
+			request:      &pb.AcknowledgeAssignmentRequest{TicketId: assigned.Id},
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.description, func(t *testing.T) {
+			res, err := fs.AcknowledgeAssignment(ctx, tc.request)
+			if tc.expectedCode == codes.OK {
+				require.NoError(t, err)
+				require.NotNil(t, res.AssignmentAcknowledgeTime)
+				require.Equal(t, "1.2.3.4:1234", res.Assignment.Connection)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, tc.expectedCode.String(), status.Convert(err).Code().String())
+				require.Contains(t, status.Convert(err).Message(), tc.expectedMessage)
+			}
+		})
+	}
+}
+
+func TestTicketOwner(t *testing.T) {
+	cfg := viper.New()
+	store, closer := statestoreTesting.NewStoreServiceForTesting(t, cfg)
+	defer closer()
+	ctx := utilTesting.NewContext(t)
+	fs := frontendService{cfg: cfg, store: store}
+
+	player := auth.NewContext(ctx, &auth.Identity{Subject: "player", OwnTicketsOnly: true})
+	other := auth.NewContext(ctx, &auth.Identity{Subject: "other", OwnTicketsOnly: true})
+	director := auth.NewContext(ctx, &auth.Identity{Subject: "director"})
+
+	_, err := fs.CreateTicket(player, &pb.CreateTicketRequest{Ticket: &pb.Ticket{Owner: "other"}})
+	require.Equal(t, codes.InvalidArgument.String(), status.Convert(err).Code().String())
+
+	ticket, err := fs.CreateTicket(player, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.NoError(t, err)
+	require.Equal(t, "player", ticket.Owner)
+
+	_, err = fs.GetTicket(player, &pb.GetTicketRequest{TicketId: ticket.Id})
+	require.NoError(t, err)
+	_, err = fs.GetTicket(director, &pb.GetTicketRequest{TicketId: ticket.Id})
+	require.NoError(t, err)
+	_, err = fs.GetTicket(other, &pb.GetTicketRequest{TicketId: ticket.Id})
+	require.Equal(t, codes.PermissionDenied.String(), status.Convert(err).Code().String())
+
+	ticket.SearchFields = &pb.SearchFields{Tags: []string{"beta"}}
+	_, err = fs.UpdateTicket(other, &pb.UpdateTicketRequest{Ticket: ticket})
+	require.Equal(t, codes.PermissionDenied.String(), status.Convert(err).Code().String())
+	updated, err := fs.UpdateTicket(player, &pb.UpdateTicketRequest{Ticket: ticket})
+	require.NoError(t, err)
+	require.Equal(t, "player", updated.Owner)
+
+	_, err = fs.AcknowledgeAssignment(other, &pb.AcknowledgeAssignmentRequest{TicketId: ticket.Id})
+	require.Equal(t, codes.PermissionDenied.String(), status.Convert(err).Code().String())
+
+	_, err = fs.DeleteTicket(other, &pb.DeleteTicketRequest{TicketId: ticket.Id})
+	require.Equal(t, codes.PermissionDenied.String(), status.Convert(err).Code().String())
+	_, err = fs.DeleteTicket(player, &pb.DeleteTicketRequest{TicketId: ticket.Id})
+	require.NoError(t, err)
+}
+
 func TestDoGetTicket(t *testing.T) {
 	fakeTicket := &pb.Ticket{
 		Id: "1",
@@ -580,7 +813,7 @@ func TestGetBackfill(t *testing.T) {
 			ctx, cancel := context.WithCancel(utilTesting.NewContext(t))
 			store, closer := statestoreTesting.NewStoreServiceForTesting(t, viper.New())
 			defer closer()
-			fs := frontendService{cfg, store}
+			fs := frontendService{cfg: cfg, store: store}
 
 			test.preAction(ctx, cancel, store)
 
@@ -613,7 +846,7 @@ func TestDoDeleteBackfill(t *testing.T) {
 	require.NoError(t, err)
 
 	cfg := viper.New()
-	fs := frontendService{cfg, store}
+	fs := frontendService{cfg: cfg, store: store}
 
 	tests := []struct {
 		description string
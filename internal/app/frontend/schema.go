@@ -0,0 +1,379 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	configNameSchemaEnable = "ticketSchema.enable"
+	configNameSchema       = "ticketSchema"
+)
+
+// schema restricts the SearchFields and Extensions of the Tickets and
+// Backfills created or updated through the frontend, so that typos in their
+// names don't silently make them unmatchable:
+//
+//	ticketSchema:
+//	  enable: true
+//	  maxBytes: 4096
+//	  maxSearchFields: 16
+//	  doubleArgs:
+//	    - name: mmr
+//	      required: true
+//	      min: 0
+//	      max: 5000
+//	  stringArgs:
+//	    - name: mode
+//	      values: [ranked, casual]
+//	  tags: [beginner, veteran]
+//	  extensionTypes: [type.googleapis.com/mygame.PlayerData]
+//
+// If doubleArgs, stringArgs, tags or extensionTypes are set, names which are
+// not listed are rejected unless allowUnknownDoubleArgs, allowUnknownStringArgs,
+// allowUnknownTags or allowUnknownExtensionTypes is set.
+type schema struct {
+	maxBytes        int
+	maxSearchFields int
+
+	doubleArgs             map[string]*doubleArgSchema
+	requiredDoubleArgs     []string
+	allowUnknownDoubleArgs bool
+	stringArgs             map[string]*stringArgSchema
+	requiredStringArgs     []string
+	allowUnknownStringArgs bool
+	tags                   map[string]bool
+	allowUnknownTags       bool
+	extensionTypes         map[string]bool
+	allowUnknownExtensions bool
+}
+
+type doubleArgSchema struct {
+	min *float64
+	max *float64
+}
+
+type stringArgSchema struct {
+	values    map[string]bool
+	maxLength int
+}
+
+// parseSchema returns the *schema of the configuration.
+func parseSchema(cfg config.View) (interface{}, error) {
+	return newSchema(cfg)
+}
+
+// newSchema returns nil if the schema is disabled.
+func newSchema(cfg config.View) (*schema, error) {
+	if !cfg.GetBool(configNameSchemaEnable) {
+		return nil, nil
+	}
+
+	raw, err := stringMap(cfg.Get(configNameSchema))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s", configNameSchema)
+	}
+
+	s := &schema{
+		doubleArgs:     make(map[string]*doubleArgSchema),
+		stringArgs:     make(map[string]*stringArgSchema),
+		tags:           make(map[string]bool),
+		extensionTypes: make(map[string]bool),
+	}
+	if s.maxBytes, err = intValue(raw["maxbytes"]); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s.maxBytes", configNameSchema)
+	}
+	if s.maxSearchFields, err = intValue(raw["maxsearchfields"]); err != nil {
+		return nil, errors.Wrapf(err, "invalid %s.maxSearchFields", configNameSchema)
+	}
+	s.allowUnknownDoubleArgs, _ = raw["allowunknowndoubleargs"].(bool)
+	s.allowUnknownStringArgs, _ = raw["allowunknownstringargs"].(bool)
+	s.allowUnknownTags, _ = raw["allowunknowntags"].(bool)
+	s.allowUnknownExtensions, _ = raw["allowunknownextensiontypes"].(bool)
+
+	doubleArgs, err := list(raw["doubleargs"])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s.doubleArgs", configNameSchema)
+	}
+	for i, v := range doubleArgs {
+		arg, err := stringMap(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s.doubleArgs[%d]", configNameSchema, i)
+		}
+		name, _ := arg["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("invalid %s.doubleArgs[%d]: name is required", configNameSchema, i)
+		}
+		d := &doubleArgSchema{}
+		if required, _ := arg["required"].(bool); required {
+			s.requiredDoubleArgs = append(s.requiredDoubleArgs, name)
+		}
+		if d.min, err = optionalFloat(arg["min"]); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s.doubleArgs[%d].min", configNameSchema, i)
+		}
+		if d.max, err = optionalFloat(arg["max"]); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s.doubleArgs[%d].max", configNameSchema, i)
+		}
+		s.doubleArgs[name] = d
+	}
+
+	stringArgs, err := list(raw["stringargs"])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s.stringArgs", configNameSchema)
+	}
+	for i, v := range stringArgs {
+		arg, err := stringMap(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s.stringArgs[%d]", configNameSchema, i)
+		}
+		name, _ := arg["name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("invalid %s.stringArgs[%d]: name is required", configNameSchema, i)
+		}
+		sa := &stringArgSchema{values: make(map[string]bool)}
+		if required, _ := arg["required"].(bool); required {
+			s.requiredStringArgs = append(s.requiredStringArgs, name)
+		}
+		if sa.maxLength, err = intValue(arg["maxlength"]); err != nil {
+			return nil, errors.Wrapf(err, "invalid %s.stringArgs[%d].maxLength", configNameSchema, i)
+		}
+		values, err := stringList(arg["values"])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s.stringArgs[%d].values", configNameSchema, i)
+		}
+		for _, value := range values {
+			sa.values[value] = true
+		}
+		s.stringArgs[name] = sa
+	}
+
+	tags, err := stringList(raw["tags"])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s.tags", configNameSchema)
+	}
+	for _, tag := range tags {
+		s.tags[tag] = true
+	}
+
+	extensionTypes, err := stringList(raw["extensiontypes"])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s.extensionTypes", configNameSchema)
+	}
+	for _, typeURL := range extensionTypes {
+		s.extensionTypes[typeURL] = true
+	}
+
+	return s, nil
+}
+
+func (s *schema) validateTicket(ticket *pb.Ticket, size int) error {
+	if s == nil {
+		return nil
+	}
+	return s.validate("ticket", ticket.GetSearchFields(), ticket.GetExtensions(), size)
+}
+
+func (s *schema) validateBackfill(backfill *pb.Backfill, size int) error {
+	if s == nil {
+		return nil
+	}
+	return s.validate("backfill", backfill.GetSearchFields(), backfill.GetExtensions(), size)
+}
+
+// validate returns an INVALID_ARGUMENT error with a BadRequest detail listing
+// every violation of the schema.
+func (s *schema) validate(field string, sf *pb.SearchFields, extensions map[string]*any.Any, size int) error {
+	var violations []*errdetails.BadRequest_FieldViolation
+	violate := func(field, format string, a ...interface{}) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: fmt.Sprintf(format, a...),
+		})
+	}
+
+	if s.maxBytes > 0 && size > s.maxBytes {
+		violate(field, "size is %d bytes, maximum is %d", size, s.maxBytes)
+	}
+	count := len(sf.GetDoubleArgs()) + len(sf.GetStringArgs()) + len(sf.GetTags())
+	if s.maxSearchFields > 0 && count > s.maxSearchFields {
+		violate(field+".search_fields", "has %d search fields, maximum is %d", count, s.maxSearchFields)
+	}
+
+	for _, name := range s.requiredDoubleArgs {
+		if _, ok := sf.GetDoubleArgs()[name]; !ok {
+			violate(fmt.Sprintf("%s.search_fields.double_args[%s]", field, name), "is required")
+		}
+	}
+	for name, value := range sf.GetDoubleArgs() {
+		argField := fmt.Sprintf("%s.search_fields.double_args[%s]", field, name)
+		d, ok := s.doubleArgs[name]
+		switch {
+		case !ok:
+			if len(s.doubleArgs) > 0 && !s.allowUnknownDoubleArgs {
+				violate(argField, "is not defined in the schema")
+			}
+		case d.min != nil && value < *d.min:
+			violate(argField, "%v is less than the minimum %v", value, *d.min)
+		case d.max != nil && value > *d.max:
+			violate(argField, "%v is greater than the maximum %v", value, *d.max)
+		}
+	}
+
+	for _, name := range s.requiredStringArgs {
+		if _, ok := sf.GetStringArgs()[name]; !ok {
+			violate(fmt.Sprintf("%s.search_fields.string_args[%s]", field, name), "is required")
+		}
+	}
+	for name, value := range sf.GetStringArgs() {
+		argField := fmt.Sprintf("%s.search_fields.string_args[%s]", field, name)
+		sa, ok := s.stringArgs[name]
+		switch {
+		case !ok:
+			if len(s.stringArgs) > 0 && !s.allowUnknownStringArgs {
+				violate(argField, "is not defined in the schema")
+			}
+		case sa.maxLength > 0 && len(value) > sa.maxLength:
+			violate(argField, "length is %d, maximum is %d", len(value), sa.maxLength)
+		case len(sa.values) > 0 && !sa.values[value]:
+			violate(argField, "%q is not one of the allowed values", value)
+		}
+	}
+
+	if len(s.tags) > 0 && !s.allowUnknownTags {
+		for i, tag := range sf.GetTags() {
+			if !s.tags[tag] {
+				violate(fmt.Sprintf("%s.search_fields.tags[%d]", field, i), "%q is not defined in the schema", tag)
+			}
+		}
+	}
+
+	if len(s.extensionTypes) > 0 && !s.allowUnknownExtensions {
+		for name, ext := range extensions {
+			if !s.extensionTypes[ext.GetTypeUrl()] {
+				violate(fmt.Sprintf("%s.extensions[%s]", field, name), "type %q is not allowed", ext.GetTypeUrl())
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	sort.Slice(violations, func(i, j int) bool {
+		return violations[i].Field < violations[j].Field
+	})
+
+	msg := fmt.Sprintf("%s does not match the ticket schema: %s %s", field, violations[0].Field, violations[0].Description)
+	if len(violations) > 1 {
+		msg += fmt.Sprintf(" (and %d more violations)", len(violations)-1)
+	}
+	st, err := status.New(codes.InvalidArgument, msg).WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if err != nil {
+		return status.Error(codes.InvalidArgument, msg)
+	}
+	return st.Err()
+}
+
+// stringMap converts the maps read from the configuration, with lower case
+// keys as viper doesn't preserve their case.
+func stringMap(v interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	switch m := v.(type) {
+	case nil:
+	case map[string]interface{}:
+		for k, v := range m {
+			result[strings.ToLower(k)] = v
+		}
+	case map[interface{}]interface{}:
+		for k, v := range m {
+			result[strings.ToLower(fmt.Sprint(k))] = v
+		}
+	default:
+		return nil, fmt.Errorf("expected a map, got %T", v)
+	}
+	return result, nil
+}
+
+func list(v interface{}) ([]interface{}, error) {
+	switch l := v.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		return l, nil
+	case []map[string]interface{}:
+		result := make([]interface{}, len(l))
+		for i := range l {
+			result[i] = l[i]
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("expected a list, got %T", v)
+}
+
+func stringList(v interface{}) ([]string, error) {
+	if l, ok := v.([]string); ok {
+		return l, nil
+	}
+	items, err := list(v)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		result = append(result, fmt.Sprint(item))
+	}
+	return result, nil
+}
+
+func optionalFloat(v interface{}) (*float64, error) {
+	var f float64
+	switch n := v.(type) {
+	case nil:
+		return nil, nil
+	case int:
+		f = float64(n)
+	case int64:
+		f = float64(n)
+	case float64:
+		f = n
+	case string:
+		var err error
+		if f, err = strconv.ParseFloat(n, 64); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("expected a number, got %T", v)
+	}
+	return &f, nil
+}
+
+func intValue(v interface{}) (int, error) {
+	f, err := optionalFloat(v)
+	if err != nil || f == nil {
+		return 0, err
+	}
+	return int(*f), nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func newTestSchema(t *testing.T) *schema {
+	cfg := viper.New()
+	cfg.Set("ticketSchema", map[string]interface{}{
+		"enable":          true,
+		"maxBytes":        200,
+		"maxSearchFields": 5,
+		"doubleArgs": []interface{}{
+			map[string]interface{}{"name": "mmr", "required": true, "min": 0, "max": 5000},
+			map[string]interface{}{"name": "latency"},
+		},
+		"stringArgs": []interface{}{
+			map[string]interface{}{"name": "mode", "values": []interface{}{"ranked", "casual"}},
+		},
+		"tags":                   []interface{}{"beginner"},
+		"extensionTypes":         []interface{}{"type.googleapis.com/mygame.PlayerData"},
+		"allowUnknownStringArgs": true,
+	})
+
+	s, err := newSchema(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	return s
+}
+
+func fieldViolations(t *testing.T, err error) map[string]string {
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	violations := map[string]string{}
+	for _, v := range st.Details()[0].(*errdetails.BadRequest).GetFieldViolations() {
+		violations[v.GetField()] = v.GetDescription()
+	}
+	return violations
+}
+
+func TestSchema(t *testing.T) {
+	s := newTestSchema(t)
+
+	valid := &pb.Ticket{
+		SearchFields: &pb.SearchFields{
+			DoubleArgs: map[string]float64{"mmr": 1000, "latency": 20},
+			StringArgs: map[string]string{"mode": "ranked", "region": "eu"},
+			Tags:       []string{"beginner"},
+		},
+		Extensions: map[string]*any.Any{
+			"player": {TypeUrl: "type.googleapis.com/mygame.PlayerData"},
+		},
+	}
+	require.NoError(t, s.validateTicket(valid, 100))
+
+	invalid := &pb.Ticket{
+		SearchFields: &pb.SearchFields{
+			DoubleArgs: map[string]float64{"mrr": 1000, "latency": 20},
+			StringArgs: map[string]string{"mode": "rankd"},
+			Tags:       []string{"beginer"},
+		},
+		Extensions: map[string]*any.Any{
+			"player": {TypeUrl: "type.googleapis.com/google.protobuf.StringValue"},
+		},
+	}
+	require.Equal(t, map[string]string{
+		"ticket":                                 "size is 300 bytes, maximum is 200",
+		"ticket.search_fields.double_args[mmr]":  "is required",
+		"ticket.search_fields.double_args[mrr]":  "is not defined in the schema",
+		"ticket.search_fields.string_args[mode]": `"rankd" is not one of the allowed values`,
+		"ticket.search_fields.tags[0]":           `"beginer" is not defined in the schema`,
+		"ticket.extensions[player]":              `type "type.googleapis.com/google.protobuf.StringValue" is not allowed`,
+	}, fieldViolations(t, s.validateTicket(invalid, 300)))
+
+	outOfBounds := &pb.Backfill{
+		SearchFields: &pb.SearchFields{
+			DoubleArgs: map[string]float64{"mmr": -1},
+			StringArgs: map[string]string{"a": "", "b": "", "c": "", "d": "", "e": ""},
+		},
+	}
+	require.Equal(t, map[string]string{
+		"backfill.search_fields":                  "has 6 search fields, maximum is 5",
+		"backfill.search_fields.double_args[mmr]": "-1 is less than the minimum 0",
+	}, fieldViolations(t, s.validateBackfill(outOfBounds, 100)))
+}
+
+func TestSchemaDisabled(t *testing.T) {
+	s, err := newSchema(viper.New())
+	require.NoError(t, err)
+	require.Nil(t, s)
+	require.NoError(t, s.validateTicket(&pb.Ticket{}, 0))
+	require.NoError(t, s.validateBackfill(&pb.Backfill{}, 0))
+
+	cfg := viper.New()
+	cfg.Set("ticketSchema.enable", true)
+	cfg.Set("ticketSchema.doubleArgs", []interface{}{map[string]interface{}{"min": 0}})
+	_, err = newSchema(cfg)
+	require.Error(t, err)
+}
+
+func TestSetSchema(t *testing.T) {
+	s := &frontendService{}
+	require.Nil(t, s.currentSchema())
+
+	cfg := viper.New()
+	cfg.Set("ticketSchema.enable", true)
+	cfg.Set("ticketSchema.maxBytes", 10)
+	v, err := parseSchema(cfg)
+	require.NoError(t, err)
+	s.setSchema(v)
+	require.Error(t, s.currentSchema().validateTicket(&pb.Ticket{}, 20))
+
+	// A reload disabling the schema stops the validation.
+	v, err = parseSchema(viper.New())
+	require.NoError(t, err)
+	s.setSchema(v)
+	require.Nil(t, s.currentSchema())
+	require.NoError(t, s.currentSchema().validateTicket(&pb.Ticket{}, 20))
+}
@@ -153,8 +153,9 @@ func updateTicketCache(store statestore.Service, value interface{}) error {
 	}
 
 	deletedCount := 0
-	for id := range tickets {
-		if _, ok := currentAll[id]; !ok {
+	for id, ticket := range tickets {
+		generation, ok := currentAll[id]
+		if !ok || ticket.Generation < int64(generation) {
 			delete(tickets, id)
 			deletedCount++
 		}
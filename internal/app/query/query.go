@@ -19,6 +19,7 @@ import (
 	"go.opencensus.io/stats/view"
 	"google.golang.org/grpc"
 	"open-match.dev/open-match/internal/appmain"
+	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/statestore"
 	"open-match.dev/open-match/internal/telemetry"
 	"open-match.dev/open-match/pkg/pb"
@@ -78,13 +79,20 @@ var (
 
 // BindService creates the query service and binds it to the serving harness.
 func BindService(p *appmain.Params, b *appmain.Bindings) error {
-	store := statestore.New(p.Config())
+	store := statestore.NewWithClock(p.Config(), p.Clock())
 	service := &queryService{
-		cfg: p.Config(),
-		tc:  newTicketCache(b, store),
-		bc:  newBackfillCache(b, store),
+		cfg:   p.Config(),
+		clock: p.Clock(),
+		tc:    newTicketCache(b, store),
+		bc:    newBackfillCache(b, store),
 	}
 
+	unsubscribe, err := config.Subscribe(p.Config(), parsePageSize, service.setPageSize)
+	if err != nil {
+		return err
+	}
+	b.AddCloser(unsubscribe)
+
 	b.AddHandleFunc(func(s *grpc.Server) {
 		pb.RegisterQueryServiceServer(s, service)
 	}, pb.RegisterQueryServiceHandlerFromEndpoint)
@@ -15,12 +15,15 @@
 package query
 
 import (
+	"sync/atomic"
+
 	"go.opencensus.io/stats"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/clock"
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/filter"
 	"open-match.dev/open-match/pkg/pb"
@@ -36,9 +39,21 @@ var (
 // queryService API provides utility functions for common MMF functionality such
 // as retrieving Tickets from state storage.
 type queryService struct {
-	cfg config.View
-	tc  *cache
-	bc  *cache
+	cfg   config.View
+	clock clock.Clock
+	tc    *cache
+	bc    *cache
+	// pSize is the page size of the active configuration, set by setPageSize.
+	pSize int64
+}
+
+func (s *queryService) pageSize() int {
+	return int(atomic.LoadInt64(&s.pSize))
+}
+
+// setPageSize applies the page size returned by parsePageSize.
+func (s *queryService) setPageSize(v interface{}) {
+	atomic.StoreInt64(&s.pSize, int64(v.(int)))
 }
 
 func (s *queryService) QueryTickets(req *pb.QueryTicketsRequest, responseServer pb.QueryService_QueryTicketsServer) error {
@@ -48,7 +63,7 @@ func (s *queryService) QueryTickets(req *pb.QueryTicketsRequest, responseServer
 		return status.Error(codes.InvalidArgument, ".pool is required")
 	}
 
-	pf, err := filter.NewPoolFilter(pool)
+	pf, err := filter.NewPoolFilterAt(pool, s.clock.Now())
 	if err != nil {
 		return err
 	}
@@ -73,7 +88,7 @@ func (s *queryService) QueryTickets(req *pb.QueryTicketsRequest, responseServer
 	}
 	stats.Record(ctx, ticketsPerQuery.M(int64(len(results))))
 
-	pSize := getPageSize(s.cfg)
+	pSize := s.pageSize()
 	for start := 0; start < len(results); start += pSize {
 		end := start + pSize
 		if end > len(results) {
@@ -98,7 +113,7 @@ func (s *queryService) QueryTicketIds(req *pb.QueryTicketIdsRequest, responseSer
 		return status.Error(codes.InvalidArgument, ".pool is required")
 	}
 
-	pf, err := filter.NewPoolFilter(pool)
+	pf, err := filter.NewPoolFilterAt(pool, s.clock.Now())
 	if err != nil {
 		return err
 	}
@@ -123,7 +138,7 @@ func (s *queryService) QueryTicketIds(req *pb.QueryTicketIdsRequest, responseSer
 	}
 	stats.Record(ctx, ticketsPerQuery.M(int64(len(results))))
 
-	pSize := getPageSize(s.cfg)
+	pSize := s.pageSize()
 	for start := 0; start < len(results); start += pSize {
 		end := start + pSize
 		if end > len(results) {
@@ -148,7 +163,7 @@ func (s *queryService) QueryBackfills(req *pb.QueryBackfillsRequest, responseSer
 		return status.Error(codes.InvalidArgument, ".pool is required")
 	}
 
-	pf, err := filter.NewPoolFilter(pool)
+	pf, err := filter.NewPoolFilterAt(pool, s.clock.Now())
 	if err != nil {
 		return err
 	}
@@ -173,7 +188,7 @@ func (s *queryService) QueryBackfills(req *pb.QueryBackfillsRequest, responseSer
 	}
 	stats.Record(ctx, backfillsPerQuery.M(int64(len(results))))
 
-	pSize := getPageSize(s.cfg)
+	pSize := s.pageSize()
 	for start := 0; start < len(results); start += pSize {
 		end := start + pSize
 		if end > len(results) {
@@ -191,6 +206,15 @@ func (s *queryService) QueryBackfills(req *pb.QueryBackfillsRequest, responseSer
 	return nil
 }
 
+// parsePageSize returns the page size of the configuration, and rejects page
+// sizes which are not a positive number.
+func parsePageSize(cfg config.View) (interface{}, error) {
+	if cfg.IsSet("queryPageSize") && cfg.GetInt("queryPageSize") <= 0 {
+		return nil, errors.Errorf("queryPageSize must be a positive number, got %q", cfg.GetString("queryPageSize"))
+	}
+	return getPageSize(cfg), nil
+}
+
 func getPageSize(cfg config.View) int {
 	const (
 		name = "queryPageSize"
@@ -66,3 +66,21 @@ func TestGetPageSize(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePageSize(t *testing.T) {
+	cfg := viper.New()
+	v, err := parsePageSize(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 1000, v)
+
+	cfg.Set("queryPageSize", "500")
+	v, err = parsePageSize(cfg)
+	require.NoError(t, err)
+	s := &queryService{}
+	s.setPageSize(v)
+	require.Equal(t, 500, s.pageSize())
+
+	cfg.Set("queryPageSize", "many")
+	_, err = parsePageSize(cfg)
+	require.Error(t, err)
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/telemetry"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	configNameQualityEnable     = "matchQuality.enable"
+	configNameQualityDoubleArgs = "matchQuality.doubleArgs"
+	configNameQualityCapacity   = "matchQuality.capacity"
+	configNameQualityProfiles   = "matchQuality.profiles"
+)
+
+// matchQuality records the quality metrics of the matches accepted by the
+// evaluator:
+//   - the time waited by their tickets since their creation,
+//   - the spread (max - min) of the configured double args of their tickets,
+//   - their fill rate, the number of tickets over the capacity configured for
+//     their profile.
+type matchQuality struct {
+	tags *telemetry.TagLimiter
+	now  func() time.Time
+
+	mu  sync.RWMutex
+	cfg *matchQualityConfig
+}
+
+type matchQualityConfig struct {
+	enabled    bool
+	doubleArgs []string
+	// capacity is the number of tickets of a full match, 0 if the fill rate
+	// is not recorded.
+	capacity   int
+	capacities map[string]int
+}
+
+func newMatchQuality(cfg config.View) *matchQuality {
+	return &matchQuality{
+		tags: telemetry.NewTagLimiter(cfg),
+		now:  time.Now,
+		cfg:  &matchQualityConfig{},
+	}
+}
+
+// parseMatchQuality reads the matchQuality configuration.
+func parseMatchQuality(cfg config.View) (interface{}, error) {
+	c := &matchQualityConfig{
+		enabled:    cfg.GetBool(configNameQualityEnable),
+		doubleArgs: cfg.GetStringSlice(configNameQualityDoubleArgs),
+		capacity:   cfg.GetInt(configNameQualityCapacity),
+		capacities: make(map[string]int),
+	}
+	if !c.enabled {
+		return c, nil
+	}
+	if c.capacity < 0 {
+		return nil, errors.Errorf("%s must not be negative", configNameQualityCapacity)
+	}
+
+	profiles, ok := cfg.Get(configNameQualityProfiles).([]interface{})
+	if !ok && cfg.IsSet(configNameQualityProfiles) {
+		return nil, errors.Errorf("%s must be a list", configNameQualityProfiles)
+	}
+	for i, v := range profiles {
+		var name, capacity interface{}
+		switch p := v.(type) {
+		case map[string]interface{}:
+			name, capacity = p["name"], p["capacity"]
+		case map[interface{}]interface{}:
+			name, capacity = p["name"], p["capacity"]
+		default:
+			return nil, errors.Errorf("%s[%d] must be a map", configNameQualityProfiles, i)
+		}
+		n, _ := name.(string)
+		if n == "" {
+			return nil, errors.Errorf("%s[%d].name is required", configNameQualityProfiles, i)
+		}
+		size, err := strconv.Atoi(fmt.Sprint(capacity))
+		if err != nil || size < 0 {
+			return nil, errors.Errorf("%s[%d].capacity must be a positive integer", configNameQualityProfiles, i)
+		}
+		c.capacities[n] = size
+	}
+	return c, nil
+}
+
+func (q *matchQuality) apply(v interface{}) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.cfg = v.(*matchQualityConfig)
+}
+
+// config returns the configuration applied last, nil if q is nil.
+func (q *matchQuality) config() *matchQualityConfig {
+	if q == nil {
+		return nil
+	}
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.cfg
+}
+
+// enabled returns whether the quality metrics of the matches are recorded.
+func (q *matchQuality) enabled() bool {
+	cfg := q.config()
+	return cfg != nil && cfg.enabled
+}
+
+// record records the quality metrics of an accepted match.
+func (q *matchQuality) record(ctx context.Context, match *pb.Match) {
+	// The same configuration is used for the whole match, even if it is
+	// reloaded meanwhile.
+	cfg := q.config()
+	if cfg == nil || !cfg.enabled {
+		return
+	}
+
+	ctx, err := tag.New(ctx, q.tags.Upsert(telemetry.KeyProfile, match.GetMatchProfile()))
+	if err != nil {
+		logger.WithError(err).Info("cannot tag the match quality metrics")
+		return
+	}
+
+	now := q.now()
+	for _, t := range match.GetTickets() {
+		if t.GetCreateTime() == nil {
+			continue
+		}
+		created, err := ptypes.Timestamp(t.GetCreateTime())
+		if err != nil {
+			continue
+		}
+		stats.Record(ctx, matchTicketWaitTime.M(float64(now.Sub(created))/float64(time.Millisecond)))
+	}
+
+	for _, arg := range cfg.doubleArgs {
+		min, max := math.Inf(1), math.Inf(-1)
+		n := 0
+		for _, t := range match.GetTickets() {
+			v, ok := t.GetSearchFields().GetDoubleArgs()[arg]
+			if !ok {
+				continue
+			}
+			min, max = math.Min(min, v), math.Max(max, v)
+			n++
+		}
+		if n < 2 {
+			continue
+		}
+		if err := stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(keyDoubleArg, arg)}, matchDoubleArgSpread.M(max-min)); err != nil {
+			logger.WithError(err).Info("cannot record the double arg spread")
+		}
+	}
+
+	capacity, ok := cfg.capacities[match.GetMatchProfile()]
+	if !ok {
+		capacity = cfg.capacity
+	}
+	if capacity > 0 {
+		backfill := strconv.FormatBool(match.GetBackfill() != nil)
+		fillRate := float64(len(match.GetTickets())) / float64(capacity)
+		if err := stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(keyBackfill, backfill)}, matchFillRate.M(fillRate)); err != nil {
+			logger.WithError(err).Info("cannot record the fill rate")
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats/view"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestParseMatchQuality(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("matchQuality.enable", true)
+	cfg.Set("matchQuality.doubleArgs", []string{"mmr"})
+	cfg.Set("matchQuality.capacity", 10)
+	cfg.Set("matchQuality.profiles", []interface{}{
+		map[interface{}]interface{}{"name": "1v1", "capacity": 2},
+	})
+	v, err := parseMatchQuality(cfg)
+	require.NoError(t, err)
+	require.Equal(t, &matchQualityConfig{
+		enabled:    true,
+		doubleArgs: []string{"mmr"},
+		capacity:   10,
+		capacities: map[string]int{"1v1": 2},
+	}, v)
+
+	cfg.Set("matchQuality.profiles", []interface{}{map[string]interface{}{"capacity": 2}})
+	_, err = parseMatchQuality(cfg)
+	require.Error(t, err)
+}
+
+func distribution(t *testing.T, v *view.View) *view.DistributionData {
+	rows, err := view.RetrieveData(v.Name)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	return rows[0].Data.(*view.DistributionData)
+}
+
+func TestMatchQualityRecord(t *testing.T) {
+	views := []*view.View{matchTicketWaitTimeView, matchDoubleArgSpreadView, matchFillRateView}
+	require.NoError(t, view.Register(views...))
+	defer view.Unregister(views...)
+
+	now := time.Now()
+	q := newMatchQuality(viper.New())
+	q.now = func() time.Time { return now }
+	q.apply(&matchQualityConfig{
+		enabled:    true,
+		doubleArgs: []string{"mmr"},
+		capacities: map[string]int{"2v2": 4},
+	})
+
+	ticket := func(wait time.Duration, mmr float64) *pb.Ticket {
+		created, err := ptypes.TimestampProto(now.Add(-wait))
+		require.NoError(t, err)
+		return &pb.Ticket{
+			CreateTime:   created,
+			SearchFields: &pb.SearchFields{DoubleArgs: map[string]float64{"mmr": mmr}},
+		}
+	}
+	q.record(context.Background(), &pb.Match{
+		MatchProfile: "2v2",
+		Tickets:      []*pb.Ticket{ticket(time.Second, 1000), ticket(3*time.Second, 1250), ticket(2*time.Second, 1100)},
+	})
+
+	wait := distribution(t, matchTicketWaitTimeView)
+	require.Equal(t, int64(3), wait.Count)
+	require.Equal(t, float64(3000), wait.Max)
+
+	spread := distribution(t, matchDoubleArgSpreadView)
+	require.Equal(t, int64(1), spread.Count)
+	require.Equal(t, float64(250), spread.Max)
+
+	fillRate := distribution(t, matchFillRateView)
+	require.Equal(t, int64(1), fillRate.Count)
+	require.Equal(t, 0.75, fillRate.Max)
+}
@@ -0,0 +1,114 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// GetStatus returns the running and the last cycles.
+func (s *synchronizerService) GetStatus(ctx context.Context, req *pb.GetSynchronizerStatusRequest) (*pb.GetSynchronizerStatusResponse, error) {
+	return s.status.get(s.clock.Now()), nil
+}
+
+// cycleStatus tracks the running and the last cycles. The cycles are updated
+// by the stages of runCycle, which may still run for a cycle once the next one
+// started: updates are made to the cycle given, and phases only change while
+// it is running.
+type cycleStatus struct {
+	mu        sync.Mutex
+	current   *pb.SynchronizerCycle
+	last      *pb.SynchronizerCycle
+	completed int64
+}
+
+func (cs *cycleStatus) startCycle(now time.Time) *pb.SynchronizerCycle {
+	start, _ := ptypes.TimestampProto(now)
+	c := &pb.SynchronizerCycle{
+		Phase:     pb.SynchronizerCycle_REGISTRATION,
+		StartTime: start,
+	}
+
+	cs.mu.Lock()
+	cs.current = c
+	cs.mu.Unlock()
+	return c
+}
+
+func (cs *cycleStatus) update(c *pb.SynchronizerCycle, f func(c *pb.SynchronizerCycle)) {
+	cs.mu.Lock()
+	f(c)
+	cs.mu.Unlock()
+}
+
+func (cs *cycleStatus) setPhase(c *pb.SynchronizerCycle, phase pb.SynchronizerCycle_Phase) {
+	cs.mu.Lock()
+	if cs.current == c {
+		c.Phase = phase
+	}
+	cs.mu.Unlock()
+}
+
+// fail records the first error canceling the cycle.
+func (cs *cycleStatus) fail(c *pb.SynchronizerCycle, err error) {
+	cs.update(c, func(c *pb.SynchronizerCycle) {
+		if c.Error == "" {
+			c.Error = err.Error()
+		}
+	})
+}
+
+func (cs *cycleStatus) endCycle(c *pb.SynchronizerCycle, now time.Time) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	c.Phase = pb.SynchronizerCycle_DONE
+	c.Duration = since(c, now)
+	cs.last = c
+	cs.completed++
+	if cs.current == c {
+		cs.current = nil
+	}
+}
+
+func (cs *cycleStatus) get(now time.Time) *pb.GetSynchronizerStatusResponse {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	resp := &pb.GetSynchronizerStatusResponse{CompletedCycles: cs.completed}
+	if cs.current != nil {
+		resp.CurrentCycle = proto.Clone(cs.current).(*pb.SynchronizerCycle)
+		resp.CurrentCycle.Duration = since(cs.current, now)
+	}
+	if cs.last != nil {
+		resp.LastCycle = proto.Clone(cs.last).(*pb.SynchronizerCycle)
+	}
+	return resp
+}
+
+func since(c *pb.SynchronizerCycle, now time.Time) *duration.Duration {
+	start, err := ptypes.Timestamp(c.GetStartTime())
+	if err != nil {
+		return nil
+	}
+	return ptypes.DurationProto(now.Sub(start))
+}
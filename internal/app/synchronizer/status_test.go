@@ -0,0 +1,64 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package synchronizer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestCycleStatus(t *testing.T) {
+	require := require.New(t)
+	cs := &cycleStatus{}
+	start := time.Now()
+
+	resp := cs.get(start)
+	require.Nil(resp.GetCurrentCycle())
+	require.Nil(resp.GetLastCycle())
+
+	c := cs.startCycle(start)
+	cs.update(c, func(c *pb.SynchronizerCycle) { c.Registrations++ })
+	cs.setPhase(c, pb.SynchronizerCycle_PROPOSAL_COLLECTION)
+	cs.update(c, func(c *pb.SynchronizerCycle) { c.Proposals += 3 })
+
+	resp = cs.get(start.Add(time.Second))
+	require.Equal(pb.SynchronizerCycle_PROPOSAL_COLLECTION, resp.GetCurrentCycle().GetPhase())
+	require.Equal(int32(1), resp.GetCurrentCycle().GetRegistrations())
+	require.Equal(int32(3), resp.GetCurrentCycle().GetProposals())
+	d, err := ptypes.Duration(resp.GetCurrentCycle().GetDuration())
+	require.NoError(err)
+	require.Equal(time.Second, d)
+
+	cs.fail(c, errors.New("evaluator failed"))
+	cs.fail(c, errors.New("canceled"))
+	cs.endCycle(c, start.Add(2*time.Second))
+
+	// Stages of the ended cycle no longer change its phase.
+	cs.setPhase(c, pb.SynchronizerCycle_EVALUATION)
+
+	resp = cs.get(start.Add(3 * time.Second))
+	require.Nil(resp.GetCurrentCycle())
+	require.Equal(int64(1), resp.GetCompletedCycles())
+	require.Equal(pb.SynchronizerCycle_DONE, resp.GetLastCycle().GetPhase())
+	require.Equal("evaluator failed", resp.GetLastCycle().GetError())
+	d, err = ptypes.Duration(resp.GetLastCycle().GetDuration())
+	require.NoError(err)
+	require.Equal(2*time.Second, d)
+}
@@ -17,8 +17,10 @@ package synchronizer
 import (
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
 	"google.golang.org/grpc"
 	"open-match.dev/open-match/internal/appmain"
+	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/ipb"
 	"open-match.dev/open-match/internal/statestore"
 	"open-match.dev/open-match/internal/telemetry"
@@ -28,6 +30,12 @@ var (
 	iterationLatency        = stats.Float64("open-match.dev/synchronizer/iteration_latency", "Time elapsed of each synchronizer iteration", stats.UnitMilliseconds)
 	registrationWaitTime    = stats.Float64("open-match.dev/synchronizer/registration_wait_time", "Time elapsed of registration wait time", stats.UnitMilliseconds)
 	registrationMMFDoneTime = stats.Float64("open-match.dev/synchronizer/registration_mmf_done_time", "Time elapsed wasted in registration window with done MMFs", stats.UnitMilliseconds)
+	matchTicketWaitTime     = stats.Float64("open-match.dev/synchronizer/match_ticket_wait_time", "Time waited by the tickets of accepted matches since their creation", stats.UnitMilliseconds)
+	matchDoubleArgSpread    = stats.Float64("open-match.dev/synchronizer/match_double_arg_spread", "Difference between the highest and lowest values of a double arg within accepted matches", stats.UnitDimensionless)
+	matchFillRate           = stats.Float64("open-match.dev/synchronizer/match_fill_rate", "Number of tickets of accepted matches over the capacity of their profile", stats.UnitDimensionless)
+
+	keyDoubleArg = tag.MustNewKey("double_arg")
+	keyBackfill  = tag.MustNewKey("backfill")
 
 	iterationLatencyView = &view.View{
 		Measure:     iterationLatency,
@@ -47,12 +55,49 @@ var (
 		Description: "Time elapsed wasted in registration window with done MMFs",
 		Aggregation: telemetry.DefaultMillisecondsDistribution,
 	}
+	matchTicketWaitTimeView = &view.View{
+		Measure:     matchTicketWaitTime,
+		Name:        "open-match.dev/synchronizer/match_ticket_wait_time",
+		Description: "Time waited by the tickets of accepted matches since their creation",
+		Aggregation: view.Distribution(100, 500, 1000, 2000, 5000, 10000, 20000, 30000, 60000, 120000, 300000, 600000, 1200000, 3600000),
+		TagKeys:     []tag.Key{telemetry.KeyProfile},
+	}
+	matchDoubleArgSpreadView = &view.View{
+		Measure:     matchDoubleArgSpread,
+		Name:        "open-match.dev/synchronizer/match_double_arg_spread",
+		Description: "Difference between the highest and lowest values of a double arg within accepted matches",
+		Aggregation: view.Distribution(0.1, 0.5, 1, 2, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000),
+		TagKeys:     []tag.Key{telemetry.KeyProfile, keyDoubleArg},
+	}
+	matchFillRateView = &view.View{
+		Measure:     matchFillRate,
+		Name:        "open-match.dev/synchronizer/match_fill_rate",
+		Description: "Number of tickets of accepted matches over the capacity of their profile",
+		Aggregation: view.Distribution(0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 0.95, 1),
+		TagKeys:     []tag.Key{telemetry.KeyProfile, keyBackfill},
+	}
 )
 
 // BindService creates the synchronizer service and binds it to the serving harness.
 func BindService(p *appmain.Params, b *appmain.Bindings) error {
-	store := statestore.New(p.Config())
+	store := statestore.NewWithClock(p.Config(), p.Clock())
 	service := newSynchronizerService(p.Config(), newEvaluator(p.Config()), store)
+	unsubscribe, err := config.Subscribe(p.Config(), parseWindows, service.applyWindows)
+	if err != nil {
+		return err
+	}
+	b.AddCloser(unsubscribe)
+
+	quality := newMatchQuality(p.Config())
+	unsubscribe, err = config.Subscribe(p.Config(), parseMatchQuality, quality.apply)
+	if err != nil {
+		return err
+	}
+	b.AddCloser(unsubscribe)
+
+	service.quality = quality
+	service.clock = p.Clock()
+	quality.now = p.Clock().Now
 	b.AddHealthCheckFunc(store.HealthCheck)
 	b.AddHandleFunc(func(s *grpc.Server) {
 		ipb.RegisterSynchronizerServer(s, service)
@@ -61,6 +106,9 @@ func BindService(p *appmain.Params, b *appmain.Bindings) error {
 		iterationLatencyView,
 		registrationWaitTimeView,
 		registrationMMFDoneTimeView,
+		matchTicketWaitTimeView,
+		matchDoubleArgSpreadView,
+		matchFillRateView,
 	)
 	return nil
 }
@@ -19,12 +19,18 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opencensus.io/stats"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/sirupsen/logrus"
 	"open-match.dev/open-match/internal/appmain/contextcause"
+	"open-match.dev/open-match/internal/clock"
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/ipb"
 	"open-match.dev/open-match/internal/statestore"
@@ -36,6 +42,7 @@ var (
 		"app":       "openmatch",
 		"component": "app.synchronizer",
 	})
+	tracer = otel.Tracer("open-match.dev/open-match/internal/app/synchronizer")
 )
 
 // Matches flow through channels in the synchronizer.  Channel variable names
@@ -51,7 +58,7 @@ var (
 //   -> m2c ->
 // remember return channel m7c for match | fanInFanOut
 //   -> m3c ->
-// set mappings from matchIDs to ticketIDs| cacheMatchIDToTicketIDs
+// set mappings from matchIDs to ticketIDs| cacheMatches
 //   -> m4c -> (buffered)
 // send to evaluator                     | wrapEvaluator
 //   -> m5c -> (buffered)
@@ -62,9 +69,14 @@ var (
 // return to backend                     | Synchronize
 
 type synchronizerService struct {
-	cfg   config.View
-	store statestore.Service
-	eval  evaluator
+	cfg     config.View
+	store   statestore.Service
+	eval    evaluator
+	quality *matchQuality
+	clock   clock.Clock
+	status  *cycleStatus
+	// windows of the active configuration, set by applyWindows.
+	windows atomic.Value // windows
 
 	synchronizeRegistration chan *registrationRequest
 
@@ -75,13 +87,16 @@ type synchronizerService struct {
 
 func newSynchronizerService(cfg config.View, eval evaluator, store statestore.Service) *synchronizerService {
 	s := &synchronizerService{
-		cfg:   cfg,
-		store: store,
-		eval:  eval,
+		cfg:    cfg,
+		store:  store,
+		eval:   eval,
+		clock:  clock.Real,
+		status: &cycleStatus{},
 
 		synchronizeRegistration: make(chan *registrationRequest),
 		startCycle:              make(chan struct{}, 1),
 	}
+	s.windows.Store(readWindows(cfg))
 
 	s.startCycle <- struct{}{}
 
@@ -95,6 +110,9 @@ func (s *synchronizerService) Synchronize(stream ipb.Synchronizer_SynchronizeSer
 	// 2. Receive matches and signals from cycle, send them to backend.
 
 	registration := s.register(stream.Context())
+	// Links the trace of the call to the trace of the cycle.
+	_, span := tracer.Start(stream.Context(), "synchronizer.register", trace.WithLinks(trace.LinkFromContext(registration.cycleCtx)))
+	span.End()
 	m6cBuffer := bufferStringChannel(registration.m7c)
 	defer func() {
 		for range m6cBuffer {
@@ -183,14 +201,14 @@ type registration struct {
 	cycleCtx   context.Context
 }
 
-func (s synchronizerService) register(ctx context.Context) *registration {
+func (s *synchronizerService) register(ctx context.Context) *registration {
 	req := &registrationRequest{
 		resp: make(chan *registration),
 		ctx:  ctx,
 	}
 
-	st := time.Now()
-	defer stats.Record(ctx, registrationWaitTime.M(float64(time.Since(st))/float64(time.Millisecond)))
+	st := s.clock.Now()
+	defer stats.Record(ctx, registrationWaitTime.M(float64(s.clock.Since(st))/float64(time.Millisecond)))
 	for {
 		select {
 		case s.synchronizeRegistration <- req:
@@ -208,9 +226,20 @@ func (s synchronizerService) register(ctx context.Context) *registration {
 ///////////////////////////////////////
 
 func (s *synchronizerService) runCycle() {
-	cst := time.Now()
+	cst := s.clock.Now()
 	/////////////////////////////////////// Initialize cycle
-	ctx, cancel := contextcause.WithCancelCause(context.Background())
+	// Each cycle has its own trace, linked to the traces of the Synchronize
+	// calls which registered to it.
+	ctx, span := tracer.Start(context.Background(), "synchronizer.cycle")
+	defer span.End()
+	ctx, cancel := contextcause.WithCancelCause(ctx)
+	cycle := s.status.startCycle(cst)
+	// Errors canceling the cycle are reported by GetStatus, unlike its callers
+	// being done.
+	failCycle := func(err error) {
+		s.status.fail(cycle, err)
+		cancel(err)
+	}
 
 	m2c := make(chan mAndM7c)
 	m3c := make(chan *pb.Match)
@@ -219,6 +248,12 @@ func (s *synchronizerService) runCycle() {
 	m6c := make(chan string)
 
 	m1c := newCutoffSender(m2c)
+	_, collectionSpan := tracer.Start(ctx, "synchronizer.proposalCollection")
+	go func() {
+		<-m1c.closed
+		collectionSpan.End()
+		s.status.setPhase(cycle, pb.SynchronizerCycle_EVALUATION)
+	}()
 	// m7c, unlike other channels, is specific to a synchronize call.  There are
 	// multiple values in a given cycle.
 
@@ -236,25 +271,32 @@ func (s *synchronizerService) runCycle() {
 		}
 	}()
 
-	matchTickets := &sync.Map{}
-	go s.cacheMatchIDToTicketIDs(matchTickets, m3c, m4c)
-	go s.wrapEvaluator(ctx, cancel, bufferMatchChannel(m4c), m5c)
+	matches := &sync.Map{}
+	go s.cacheMatches(cycle, matches, m3c, m4c)
+	go s.wrapEvaluator(ctx, failCycle, bufferMatchChannel(m4c), m5c)
 	go func() {
-		s.addMatchesToPendingRelease(ctx, matchTickets, cancel, bufferStringChannel(m5c), m6c)
+		s.addMatchesToPendingRelease(ctx, cycle, matches, failCycle, bufferStringChannel(m5c), m6c)
 		// Wait for pending release, but not all matches returned, the next cycle
 		// can start now.
 		close(closedOnCycleEnd)
 	}()
 
 	/////////////////////////////////////// Run Registration Period
-	rst := time.Now()
-	closeRegistration := time.After(s.registrationInterval())
+	rst := s.clock.Now()
+	_, registrationSpan := tracer.Start(ctx, "synchronizer.registration")
+	closeRegistration := s.clock.After(s.registrationInterval())
 Registration:
 	for {
 		select {
 		case req := <-s.synchronizeRegistration:
 			allM1cSent.Add(1)
 			callingCtx = append(callingCtx, req.ctx)
+			if caller := trace.SpanContextFromContext(req.ctx); caller.IsValid() {
+				registrationSpan.AddEvent("registered", trace.WithAttributes(
+					attribute.String("caller.traceId", caller.TraceID().String()),
+					attribute.String("caller.spanId", caller.SpanID().String()),
+				))
+			}
 			r := &registration{
 				m1c:        m1c,
 				m7c:        make(chan string),
@@ -263,11 +305,15 @@ Registration:
 				allM1cSent: &allM1cSent,
 			}
 			registrations = append(registrations, r)
+			s.status.update(cycle, func(c *pb.SynchronizerCycle) { c.Registrations++ })
 			req.resp <- r
 		case <-closeRegistration:
 			break Registration
 		}
 	}
+	registrationSpan.SetAttributes(attribute.Int("registrations", len(registrations)))
+	registrationSpan.End()
+	s.status.setPhase(cycle, pb.SynchronizerCycle_PROPOSAL_COLLECTION)
 	/////////////////////////////////////// Wait for cycle completion.
 
 	go func() {
@@ -280,10 +326,10 @@ Registration:
 	go func() {
 		allM1cSent.Wait()
 		m1c.cutoff()
-		stats.Record(ctx, registrationMMFDoneTime.M(float64((s.registrationInterval()-time.Since(rst))/time.Millisecond)))
+		stats.Record(ctx, registrationMMFDoneTime.M(float64((s.registrationInterval()-s.clock.Since(rst))/time.Millisecond)))
 	}()
 
-	cancelProposalCollection := time.AfterFunc(s.proposalCollectionInterval(), func() {
+	cancelProposalCollection := s.clock.AfterFunc(s.proposalCollectionInterval(), func() {
 		m1c.cutoff()
 		for _, r := range registrations {
 			r.cancelMmfs <- struct{}{}
@@ -292,7 +338,8 @@ Registration:
 
 	<-closedOnCycleEnd
 
-	stats.Record(ctx, iterationLatency.M(float64(time.Since(cst)/time.Millisecond)))
+	stats.Record(ctx, iterationLatency.M(float64(s.clock.Since(cst)/time.Millisecond)))
+	s.status.endCycle(cycle, s.clock.Now())
 
 	// Clean up in case it was never needed.
 	cancelProposalCollection.Stop()
@@ -409,8 +456,12 @@ func (c *cutoffSender) cutoff() {
 
 // Calls the evaluator with the matches.
 func (s *synchronizerService) wrapEvaluator(ctx context.Context, cancel contextcause.CancelErrFunc, m4c <-chan []*pb.Match, m5c chan<- string) {
+	ctx, span := tracer.Start(ctx, "synchronizer.evaluation")
+	defer span.End()
+
 	err := s.eval.evaluate(ctx, m4c, m5c)
 	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
 		logger.WithFields(logrus.Fields{
 			"error": err,
 		}).Error("error calling evaluator, canceling cycle")
@@ -422,9 +473,21 @@ func (s *synchronizerService) wrapEvaluator(ctx context.Context, cancel contextc
 ///////////////////////////////////////
 ///////////////////////////////////////
 
-func (s *synchronizerService) cacheMatchIDToTicketIDs(m *sync.Map, m3c <-chan *pb.Match, m4c chan<- *pb.Match) {
+// cachedMatch holds the ticket ids of a proposed match, and the match itself
+// only if its quality is recorded once accepted.
+type cachedMatch struct {
+	ticketIDs []string
+	match     *pb.Match
+}
+
+func (s *synchronizerService) cacheMatches(cycle *pb.SynchronizerCycle, m *sync.Map, m3c <-chan *pb.Match, m4c chan<- *pb.Match) {
 	for match := range m3c {
-		m.Store(match.GetMatchId(), getTicketIds(match.GetTickets()))
+		s.status.update(cycle, func(c *pb.SynchronizerCycle) { c.Proposals++ })
+		cached := cachedMatch{ticketIDs: getTicketIds(match.GetTickets())}
+		if s.quality.enabled() {
+			cached.match = match
+		}
+		m.Store(match.GetMatchId(), cached)
 		m4c <- match
 	}
 	close(m4c)
@@ -445,16 +508,24 @@ func getTicketIds(tickets []*pb.Ticket) []string {
 // pendingRelease list.  If it partially fails for whatever reason (not all tickets will
 // necessarily be in the same call), only the matches which can be safely
 // returned to the Synchronize calls are.
-func (s *synchronizerService) addMatchesToPendingRelease(ctx context.Context, m *sync.Map, cancel contextcause.CancelErrFunc, m5c <-chan []string, m6c chan<- string) {
+func (s *synchronizerService) addMatchesToPendingRelease(ctx context.Context, cycle *pb.SynchronizerCycle, m *sync.Map, cancel contextcause.CancelErrFunc, m5c <-chan []string, m6c chan<- string) {
+	ctx, span := tracer.Start(ctx, "synchronizer.pendingRelease")
+	defer span.End()
+
 	totalMatches := 0
 	successfulMatches := 0
 	var lastErr error
 	for mIDs := range m5c {
 		ids := []string{}
+		accepted := []*pb.Match{}
 		for _, mID := range mIDs {
-			tids, ok := m.Load(mID)
+			v, ok := m.Load(mID)
 			if ok {
-				ids = append(ids, tids.([]string)...)
+				cached := v.(cachedMatch)
+				ids = append(ids, cached.ticketIDs...)
+				if cached.match != nil {
+					accepted = append(accepted, cached.match)
+				}
 			} else {
 				logger.Errorf("failed to get MatchId %s with its corresponding tickets from the cache", mID)
 			}
@@ -465,6 +536,10 @@ func (s *synchronizerService) addMatchesToPendingRelease(ctx context.Context, m
 		totalMatches += len(mIDs)
 		if err == nil {
 			successfulMatches += len(mIDs)
+			s.status.update(cycle, func(c *pb.SynchronizerCycle) { c.AcceptedMatches += int32(len(mIDs)) })
+			for _, match := range accepted {
+				s.quality.record(ctx, match)
+			}
 		} else {
 			lastErr = err
 		}
@@ -485,36 +560,59 @@ func (s *synchronizerService) addMatchesToPendingRelease(ctx context.Context, m
 			cancel(fmt.Errorf("no matches successfully added to the pending release.  Last error: %w", lastErr))
 		}
 	}
+	span.SetAttributes(
+		attribute.Int("totalMatches", totalMatches),
+		attribute.Int("successfulMatches", successfulMatches),
+	)
 	close(m6c)
 }
 
 ///////////////////////////////////////
 ///////////////////////////////////////
 
-func (s *synchronizerService) registrationInterval() time.Duration {
-	const (
-		name            = "registrationInterval"
-		defaultInterval = time.Second
-	)
+// windows are the durations of the phases of a cycle.
+type windows struct {
+	registration       time.Duration
+	proposalCollection time.Duration
+}
 
-	if !s.cfg.IsSet(name) {
-		return defaultInterval
+func readWindows(cfg config.View) windows {
+	w := windows{
+		registration:       time.Second,
+		proposalCollection: 10 * time.Second,
+	}
+	if cfg.IsSet("registrationInterval") {
+		w.registration = cfg.GetDuration("registrationInterval")
 	}
+	if cfg.IsSet("proposalCollectionInterval") {
+		w.proposalCollection = cfg.GetDuration("proposalCollectionInterval")
+	}
+	return w
+}
 
-	return s.cfg.GetDuration(name)
+// parseWindows returns the windows of the configuration, and rejects windows
+// which are not positive.
+func parseWindows(cfg config.View) (interface{}, error) {
+	for _, name := range []string{"registrationInterval", "proposalCollectionInterval"} {
+		if cfg.IsSet(name) && cfg.GetDuration(name) <= 0 {
+			return nil, fmt.Errorf("%s must be a positive duration, got %q", name, cfg.GetString(name))
+		}
+	}
+	return readWindows(cfg), nil
 }
 
-func (s *synchronizerService) proposalCollectionInterval() time.Duration {
-	const (
-		name            = "proposalCollectionInterval"
-		defaultInterval = 10 * time.Second
-	)
+// applyWindows makes the next cycles use the windows returned by
+// parseWindows.
+func (s *synchronizerService) applyWindows(v interface{}) {
+	s.windows.Store(v.(windows))
+}
 
-	if !s.cfg.IsSet(name) {
-		return defaultInterval
-	}
+func (s *synchronizerService) registrationInterval() time.Duration {
+	return s.windows.Load().(windows).registration
+}
 
-	return s.cfg.GetDuration(name)
+func (s *synchronizerService) proposalCollectionInterval() time.Duration {
+	return s.windows.Load().(windows).proposalCollection
 }
 
 ///////////////////////////////////////
@@ -26,6 +26,7 @@ import (
 	"go.opencensus.io/stats/view"
 
 	"github.com/sirupsen/logrus"
+	"open-match.dev/open-match/internal/clock"
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/logging"
 	"open-match.dev/open-match/internal/rpc"
@@ -70,6 +71,7 @@ type Bind func(p *Params, b *Bindings) error
 type Params struct {
 	config      config.View
 	serviceName string
+	clock       clock.Clock
 }
 
 // Config provides the configuration for the application.
@@ -83,6 +85,22 @@ func (p *Params) ServiceName() string {
 	return p.serviceName
 }
 
+// Clock is the clock the application must use for its timing, the wall clock
+// unless a simulated one was given with WithClock.
+func (p *Params) Clock() clock.Clock {
+	return p.clock
+}
+
+// Option configures an application created with NewApplication.
+type Option func(*Params)
+
+// WithClock runs the application with c instead of the wall clock.
+func WithClock(c clock.Clock) Option {
+	return func(p *Params) {
+		p.clock = c
+	}
+}
+
 // Bindings allows applications to bind various functions to the running servers.
 type Bindings struct {
 	sp       *rpc.ServerParams
@@ -144,10 +162,12 @@ func (b *Bindings) AddCloserErr(c func() error) {
 // App is used internally, and public only for apptest.  Do not use, and use apptest instead.
 type App struct {
 	closers []func() error
+	// drain lets the running streams end before the closers are called.
+	drain func() error
 }
 
 // NewApplication is used internally, and public only for apptest.  Do not use, and use apptest instead.
-func NewApplication(serviceName string, bindService Bind, getCfg func() (config.View, error), listen func(network, address string) (net.Listener, error)) (*App, error) {
+func NewApplication(serviceName string, bindService Bind, getCfg func() (config.View, error), listen func(network, address string) (net.Listener, error), opts ...Option) (*App, error) {
 	a := &App{}
 
 	cfg, err := getCfg()
@@ -167,6 +187,10 @@ func NewApplication(serviceName string, bindService Bind, getCfg func() (config.
 	p := &Params{
 		config:      cfg,
 		serviceName: serviceName,
+		clock:       clock.Real,
+	}
+	for _, opt := range opts {
+		opt(p)
 	}
 	b := &Bindings{
 		a:  a,
@@ -179,6 +203,7 @@ func NewApplication(serviceName string, bindService Bind, getCfg func() (config.
 		_ = surpressedErr
 		return nil, err
 	}
+	b.RegisterViews(sp.Views()...)
 
 	err = bindService(p, b)
 	if err != nil {
@@ -200,12 +225,20 @@ func NewApplication(serviceName string, bindService Bind, getCfg func() (config.
 		return nil, err
 	}
 	b.AddCloserErr(s.Stop)
+	a.drain = s.Drain
 
 	return a, nil
 }
 
 // Stop is used internally, and public only for apptest.  Do not use, and use apptest instead.
 func (a *App) Stop() error {
+	if a.drain != nil {
+		logger.Info("Draining the running streams.")
+		if err := a.drain(); err != nil {
+			logger.WithError(err).Warning("Stopping without waiting for the remaining streams.")
+		}
+	}
+
 	// Use closers in reverse order: Since dependencies are created before
 	// their dependants, this helps ensure no dependencies are closed
 	// unexpectedly.
@@ -0,0 +1,257 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auth authenticates the callers of the Open Match APIs and authorizes
+// their calls against the role based policy of the api.auth configuration.
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+)
+
+const (
+	configNameEnable     = "api.auth.enable"
+	configNameMTLSEnable = "api.auth.mtls.enable"
+	configNameJWTEnable  = "api.auth.jwt.enable"
+
+	authorizationMetadata = "authorization"
+	bearerPrefix          = "bearer "
+)
+
+var (
+	authLogger = logrus.WithFields(logrus.Fields{
+		"app":       "openmatch",
+		"component": "auth",
+	})
+)
+
+// Credentials are the credentials presented by a caller.
+type Credentials struct {
+	// BearerToken is the token of the "Authorization: Bearer" header.
+	BearerToken string
+	// PeerCertificates is the verified certificate chain of the TLS client.
+	PeerCertificates []*x509.Certificate
+}
+
+// Authenticator authenticates callers from the credentials they presented.
+type Authenticator interface {
+	// Authenticate returns the identity of the caller, nil if the credentials
+	// handled by the Authenticator are missing, or an error if they are invalid.
+	Authenticate(c *Credentials) (*Identity, error)
+}
+
+// AuthenticatorFunc adapts a function to the Authenticator interface.
+type AuthenticatorFunc func(c *Credentials) (*Identity, error)
+
+// Authenticate calls f(c).
+func (f AuthenticatorFunc) Authenticate(c *Credentials) (*Identity, error) {
+	return f(c)
+}
+
+// Authorizer enforces authentication and authorization for the gRPC services
+// and the HTTP gateway of a server.
+type Authorizer struct {
+	authenticators []Authenticator
+	policy         *policy
+	gateway        *gatewaySigner
+	clientCerts    bool
+}
+
+// New creates an Authorizer with the authenticators enabled in the
+// configuration. It returns nil if authentication is disabled.
+func New(cfg config.View) (*Authorizer, error) {
+	if !cfg.GetBool(configNameEnable) {
+		return nil, nil
+	}
+
+	authenticators := []Authenticator{}
+	if cfg.GetBool(configNameMTLSEnable) {
+		authenticators = append(authenticators, AuthenticatorFunc(authenticateClientCertificate))
+	}
+	if cfg.GetBool(configNameJWTEnable) {
+		v, err := newJWTVerifier(cfg)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, AuthenticatorFunc(v.authenticate))
+	}
+
+	a, err := NewWithAuthenticators(cfg, authenticators...)
+	if err != nil {
+		return nil, err
+	}
+	a.clientCerts = cfg.GetBool(configNameMTLSEnable)
+	return a, nil
+}
+
+// NewWithAuthenticators creates an Authorizer for the given authenticators,
+// which are tried in order.
+func NewWithAuthenticators(cfg config.View, authenticators ...Authenticator) (*Authorizer, error) {
+	gateway, err := newGatewaySigner()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Authorizer{
+		authenticators: authenticators,
+		policy:         &policy{cfg: cfg},
+		gateway:        gateway,
+	}, nil
+}
+
+// RequestClientCertificates returns true if TLS servers should ask clients for
+// a certificate, to authenticate them by their certificate.
+func (a *Authorizer) RequestClientCertificates() bool {
+	return a.clientCerts
+}
+
+// UnaryServerInterceptor authorizes unary calls to the protected services.
+func (a *Authorizer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorizeGRPC(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor authorizes streaming calls to the protected services.
+func (a *Authorizer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorizeGRPC(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authorizedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authorizeGRPC returns the context carrying the identity of the caller if it
+// is allowed to call the method.
+func (a *Authorizer) authorizeGRPC(ctx context.Context, fullMethod string) (context.Context, error) {
+	if !a.policy.protects(fullMethod) {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var id *Identity
+	var err error
+	if values := md.Get(gatewayIdentityMetadata); len(values) > 0 {
+		// The call was authenticated by the HTTP gateway of this server.
+		id, err = a.gateway.verify(values[0])
+	} else {
+		id, err = a.authenticate(grpcCredentials(ctx, md))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	id, err = a.policy.authorize(id, fullMethod)
+	if err != nil {
+		authLogger.WithError(err).Debug("call denied")
+		return nil, err
+	}
+	return NewContext(ctx, id), nil
+}
+
+// authenticate returns the identity of the first authenticator recognizing
+// the credentials, or the anonymous role if none of them is present.
+func (a *Authorizer) authenticate(c *Credentials) (*Identity, error) {
+	for _, authenticator := range a.authenticators {
+		id, err := authenticator.Authenticate(c)
+		if err != nil {
+			authLogger.WithError(err).Debug("authentication failed")
+			return nil, status.Errorf(codes.Unauthenticated, "invalid credentials: %v", err)
+		}
+		if id != nil {
+			return id, nil
+		}
+	}
+
+	if role := a.policy.anonymousRole(); role != "" {
+		return &Identity{Roles: []string{role}}, nil
+	}
+	return nil, status.Error(codes.Unauthenticated, "credentials are required")
+}
+
+func grpcCredentials(ctx context.Context, md metadata.MD) *Credentials {
+	c := &Credentials{}
+	if values := md.Get(authorizationMetadata); len(values) > 0 {
+		c.BearerToken = bearerToken(values[0])
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.VerifiedChains) > 0 {
+			c.PeerCertificates = info.State.VerifiedChains[0]
+		}
+	}
+	return c
+}
+
+func bearerToken(authorization string) string {
+	if len(authorization) > len(bearerPrefix) && strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return strings.TrimSpace(authorization[len(bearerPrefix):])
+	}
+	return ""
+}
+
+// authenticateClientCertificate identifies TLS clients by the first URI SAN of
+// their certificate, e.g. a SPIFFE ID, or else by its common name. The
+// organizational units of the certificate are the roles of the caller.
+func authenticateClientCertificate(c *Credentials) (*Identity, error) {
+	if len(c.PeerCertificates) == 0 {
+		return nil, nil
+	}
+
+	cert := c.PeerCertificates[0]
+	id := &Identity{
+		Subject: cert.Subject.CommonName,
+		Roles:   cert.Subject.OrganizationalUnit,
+	}
+	if len(cert.URIs) > 0 {
+		id.Subject = cert.URIs[0].String()
+	}
+	if id.Subject == "" {
+		return nil, errors.New("client certificate has no subject")
+	}
+	return id, nil
+}
+
+func (v *jwtVerifier) authenticate(c *Credentials) (*Identity, error) {
+	if c.BearerToken == "" {
+		return nil, nil
+	}
+	return v.verify(c.BearerToken)
+}
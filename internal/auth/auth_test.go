@@ -0,0 +1,236 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	getTicketMethod    = "/openmatch.FrontendService/GetTicket"
+	fetchMatchesMethod = "/openmatch.BackendService/FetchMatches"
+	evaluateMethod     = "/openmatch.Evaluator/Evaluate"
+	exportStateMethod  = "/openmatch.AdminService/ExportState"
+)
+
+func newTestPolicyConfig() *viper.Viper {
+	cfg := viper.New()
+	cfg.Set(configNameEnable, true)
+	cfg.Set("api.auth.roles.game-client.permissions", []string{
+		"/openmatch.FrontendService/CreateTicket",
+		getTicketMethod,
+	})
+	cfg.Set("api.auth.roles.game-client.ownTicketsOnly", true)
+	cfg.Set("api.auth.roles.director.permissions", []string{"/openmatch.BackendService/*"})
+	cfg.Set("api.auth.roles.admin.permissions", []string{"*"})
+	return cfg
+}
+
+// tokenAuthenticator authenticates the bearer token "<subject>:<role>".
+var tokenAuthenticator = AuthenticatorFunc(func(c *Credentials) (*Identity, error) {
+	switch c.BearerToken {
+	case "":
+		return nil, nil
+	case "player:game-client":
+		return &Identity{Subject: "player", Roles: []string{"game-client"}}, nil
+	case "director:director":
+		return &Identity{Subject: "director", Roles: []string{"director"}}, nil
+	}
+	return nil, errors.New("unknown token")
+})
+
+func TestPolicy(t *testing.T) {
+	p := &policy{cfg: newTestPolicyConfig()}
+
+	require.True(t, p.protects(getTicketMethod))
+	require.True(t, p.protects(fetchMatchesMethod))
+	require.False(t, p.protects(evaluateMethod))
+	require.True(t, p.protects(exportStateMethod))
+
+	player := &Identity{Subject: "player", Roles: []string{"game-client"}}
+	id, err := p.authorize(player, getTicketMethod)
+	require.NoError(t, err)
+	require.True(t, id.OwnTicketsOnly)
+
+	_, err = p.authorize(player, "/openmatch.FrontendService/DeleteTicket")
+	require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	_, err = p.authorize(player, fetchMatchesMethod)
+	require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+	director := &Identity{Subject: "director", Roles: []string{"director"}}
+	_, err = p.authorize(director, fetchMatchesMethod)
+	require.NoError(t, err)
+	_, err = p.authorize(director, getTicketMethod)
+	require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+	// A role granting the method without restriction lifts the restriction.
+	ops := &Identity{Subject: "ops", Roles: []string{"game-client", "admin"}}
+	id, err = p.authorize(ops, getTicketMethod)
+	require.NoError(t, err)
+	require.False(t, id.OwnTicketsOnly)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	cfg := newTestPolicyConfig()
+	a, err := NewWithAuthenticators(cfg, tokenAuthenticator)
+	require.NoError(t, err)
+	interceptor := a.UnaryServerInterceptor()
+
+	call := func(method string, md metadata.MD) (*Identity, error) {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		var got *Identity
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			got, _ = FromContext(ctx)
+			return nil, nil
+		})
+		return got, err
+	}
+
+	id, err := call(getTicketMethod, metadata.Pairs("authorization", "Bearer player:game-client"))
+	require.NoError(t, err)
+	require.Equal(t, "player", id.Subject)
+	require.True(t, id.OwnTicketsOnly)
+
+	_, err = call(getTicketMethod, metadata.Pairs("authorization", "Bearer director:director"))
+	require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+	_, err = call(getTicketMethod, metadata.Pairs("authorization", "Bearer forged"))
+	require.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+
+	_, err = call(getTicketMethod, metadata.MD{})
+	require.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+
+	// Services which are not protected can be called without credentials.
+	id, err = call(evaluateMethod, metadata.MD{})
+	require.NoError(t, err)
+	require.Nil(t, id)
+
+	// Identities can't be forged with the gateway metadata.
+	_, err = call(fetchMatchesMethod, metadata.Pairs(gatewayIdentityMetadata, "eyJzdWIiOiJhIn0.AAAA"))
+	require.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+
+	cfg.Set(configNameAnonymousRole, "game-client")
+	id, err = call(getTicketMethod, metadata.MD{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"game-client"}, id.Roles)
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	a, err := NewWithAuthenticators(newTestPolicyConfig(), tokenAuthenticator)
+	require.NoError(t, err)
+	interceptor := a.StreamServerInterceptor()
+
+	call := func(method string, md metadata.MD) error {
+		stream := &fakeServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+		return interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: method}, func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		})
+	}
+
+	// The admin service is protected without listing it in the configuration.
+	err = call(exportStateMethod, metadata.MD{})
+	require.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+
+	err = call(exportStateMethod, metadata.Pairs("authorization", "Bearer director:director"))
+	require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestHTTPHandler(t *testing.T) {
+	a, err := NewWithAuthenticators(newTestPolicyConfig(), tokenAuthenticator, AuthenticatorFunc(authenticateClientCertificate))
+	require.NoError(t, err)
+
+	var forwarded string
+	handler := a.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		forwarded = req.Header.Get(gatewayIdentityHeader)
+	}))
+
+	serve := func(req *http.Request) int {
+		forwarded = ""
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/frontendservice/tickets/1", nil)
+	req.Header.Set("Authorization", "Bearer player:game-client")
+	require.Equal(t, http.StatusOK, serve(req))
+	signed := forwarded
+	id, err := a.gateway.verify(signed)
+	require.NoError(t, err)
+	require.Equal(t, &Identity{Subject: "player", Roles: []string{"game-client"}}, id)
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/frontendservice/tickets/1", nil)
+	req.Header.Set("Authorization", "Bearer forged")
+	require.Equal(t, http.StatusUnauthorized, serve(req))
+
+	// Requests without credentials are forwarded without identity, even if
+	// the caller replays a signed identity.
+	req = httptest.NewRequest(http.MethodGet, "/v1/frontendservice/tickets/1", nil)
+	req.Header.Set(gatewayIdentityHeader, signed)
+	require.Equal(t, http.StatusOK, serve(req))
+	require.Empty(t, forwarded)
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/frontendservice/tickets/1", nil)
+	uri, err := url.Parse("spiffe://example.com/director")
+	require.NoError(t, err)
+	req.TLS = &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{{
+			Subject: pkix.Name{CommonName: "director", OrganizationalUnit: []string{"director"}},
+			URIs:    []*url.URL{uri},
+		}}},
+	}
+	require.Equal(t, http.StatusOK, serve(req))
+	id, err = a.gateway.verify(forwarded)
+	require.NoError(t, err)
+	require.Equal(t, &Identity{Subject: "spiffe://example.com/director", Roles: []string{"director"}}, id)
+}
+
+func TestCheckTicketOwner(t *testing.T) {
+	ticket := &pb.Ticket{Id: "1", Owner: "player"}
+
+	require.NoError(t, CheckTicketOwner(context.Background(), ticket))
+	require.NoError(t, CheckTicketOwner(NewContext(context.Background(), &Identity{Subject: "director"}), ticket))
+	require.NoError(t, CheckTicketOwner(NewContext(context.Background(), &Identity{Subject: "player", OwnTicketsOnly: true}), ticket))
+
+	err := CheckTicketOwner(NewContext(context.Background(), &Identity{Subject: "other", OwnTicketsOnly: true}), ticket)
+	require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	err = CheckTicketOwner(NewContext(context.Background(), &Identity{OwnTicketsOnly: true}), &pb.Ticket{Id: "2"})
+	require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+}
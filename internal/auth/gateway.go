@@ -0,0 +1,122 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// gatewayIdentityHeader is forwarded by grpc-gateway to the gRPC server as
+	// the gatewayIdentityMetadata.
+	gatewayIdentityHeader   = "Grpc-Metadata-Open-Match-Gateway-Identity"
+	gatewayIdentityMetadata = "open-match-gateway-identity"
+)
+
+// gatewaySigner signs the identities authenticated by the HTTP gateway, so the
+// gRPC server of the same process can trust them. The key never leaves the
+// process: identities forged by other callers are rejected.
+type gatewaySigner struct {
+	key []byte
+}
+
+func newGatewaySigner() (*gatewaySigner, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Wrap(err, "failed to generate the gateway signing key")
+	}
+	return &gatewaySigner{key: key}, nil
+}
+
+func (g *gatewaySigner) sign(id *Identity) (string, error) {
+	data, err := json.Marshal(id)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(g.mac(payload)), nil
+}
+
+func (g *gatewaySigner) verify(value string) (*Identity, error) {
+	parts := strings.Split(value, ".")
+	if len(parts) != 2 {
+		return nil, status.Error(codes.Unauthenticated, "malformed gateway identity")
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(mac, g.mac(parts[0])) {
+		return nil, status.Error(codes.Unauthenticated, "invalid gateway identity")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "malformed gateway identity")
+	}
+	id := &Identity{}
+	if err = json.Unmarshal(data, id); err != nil {
+		return nil, status.Error(codes.Unauthenticated, "malformed gateway identity")
+	}
+	return id, nil
+}
+
+func (g *gatewaySigner) mac(payload string) []byte {
+	h := hmac.New(sha256.New, g.key)
+	h.Write([]byte(payload))
+	return h.Sum(nil)
+}
+
+// HTTPHandler authenticates the requests to the HTTP gateway and forwards the
+// identity of the caller to the gRPC server, which authorizes the call.
+// Requests with invalid credentials are rejected with 401 Unauthorized.
+func (a *Authorizer) HTTPHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Never trust an identity set by the caller.
+		req.Header.Del(gatewayIdentityHeader)
+
+		c := &Credentials{
+			BearerToken: bearerToken(req.Header.Get("Authorization")),
+		}
+		if req.TLS != nil && len(req.TLS.VerifiedChains) > 0 {
+			c.PeerCertificates = req.TLS.VerifiedChains[0]
+		}
+
+		// Without credentials the gRPC server decides whether the method can
+		// be called anonymously.
+		if c.BearerToken != "" || len(c.PeerCertificates) > 0 {
+			id, err := a.authenticate(c)
+			if err != nil {
+				http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+				return
+			}
+			signed, err := a.gateway.sign(id)
+			if err != nil {
+				http.Error(w, "failed to forward the caller identity", http.StatusInternalServerError)
+				return
+			}
+			req.Header.Set(gatewayIdentityHeader, signed)
+		}
+
+		handler.ServeHTTP(w, req)
+	})
+}
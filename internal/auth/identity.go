@@ -0,0 +1,63 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// Identity is the authenticated caller of an Open Match API.
+type Identity struct {
+	// Subject uniquely identifies the caller, e.g. the "sub" claim of a JWT or
+	// the URI SAN or common name of a client certificate.
+	Subject string `json:"sub"`
+	// Roles are the roles of the caller in the authorization policy.
+	Roles []string `json:"roles,omitempty"`
+	// OwnTicketsOnly is true when the caller may only access the Tickets it
+	// created with the called method. It is set by the authorization policy.
+	OwnTicketsOnly bool `json:"-"`
+}
+
+type identityKey struct{}
+
+// NewContext returns a context carrying the identity of the caller.
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity of the caller, if authentication is enabled
+// for the called method.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok && id != nil
+}
+
+// CheckTicketOwner returns a PermissionDenied error if the caller is restricted
+// to its own Tickets and does not own the ticket.
+func CheckTicketOwner(ctx context.Context, ticket *pb.Ticket) error {
+	id, ok := FromContext(ctx)
+	if !ok || !id.OwnTicketsOnly {
+		return nil
+	}
+	// Anonymous callers own no Tickets.
+	if id.Subject == "" || ticket.GetOwner() != id.Subject {
+		return status.Errorf(codes.PermissionDenied, "%s is not the owner of ticket %s", id.Subject, ticket.GetId())
+	}
+	return nil
+}
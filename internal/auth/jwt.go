@@ -0,0 +1,226 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/pkg/errors"
+	"open-match.dev/open-match/internal/config"
+)
+
+const (
+	configNameJWKSFile   = "api.auth.jwt.jwksFile"
+	configNameIssuer     = "api.auth.jwt.issuer"
+	configNameAudience   = "api.auth.jwt.audience"
+	configNameRolesClaim = "api.auth.jwt.rolesClaim"
+
+	defaultRolesClaim = "roles"
+
+	// clockSkew is the tolerance when validating the expiration and not before
+	// times of a token.
+	clockSkew = 30 * time.Second
+	// minJWKSReloadInterval limits how often the JWKS file is read again when a
+	// token is signed with an unknown key.
+	minJWKSReloadInterval = 10 * time.Second
+)
+
+// signingAlgorithms are the only algorithms the tokens may be signed with.
+// The symmetric and "none" algorithms are rejected, so that a public key can't
+// be used as an HMAC secret.
+var signingAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.ES256, jose.ES384, jose.ES512,
+}
+
+// jwtVerifier validates bearer tokens against the keys of a local JWKS file.
+type jwtVerifier struct {
+	jwksFile   string
+	issuer     string
+	audience   string
+	rolesClaim string
+	now        func() time.Time
+
+	mu         sync.RWMutex
+	keys       map[string]interface{}
+	lastReload time.Time
+}
+
+func newJWTVerifier(cfg config.View) (*jwtVerifier, error) {
+	v := &jwtVerifier{
+		jwksFile:   cfg.GetString(configNameJWKSFile),
+		issuer:     cfg.GetString(configNameIssuer),
+		audience:   cfg.GetString(configNameAudience),
+		rolesClaim: defaultRolesClaim,
+		now:        time.Now,
+	}
+	if cfg.IsSet(configNameRolesClaim) {
+		v.rolesClaim = cfg.GetString(configNameRolesClaim)
+	}
+	if v.jwksFile == "" {
+		return nil, fmt.Errorf("%s is required when JWT authentication is enabled", configNameJWKSFile)
+	}
+
+	if err := v.reload(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// reload reads the keys of the JWKS file.
+func (v *jwtVerifier) reload() error {
+	data, err := ioutil.ReadFile(v.jwksFile)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read the JWKS file %s", v.jwksFile)
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse the JWKS file %s", v.jwksFile)
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.keys = keys
+	v.lastReload = v.now()
+	return nil
+}
+
+// key returns the public key with the given id, reading the JWKS file again
+// if the key is unknown, so rotated keys are picked up without a restart.
+func (v *jwtVerifier) key(kid string) (interface{}, error) {
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	lastReload := v.lastReload
+	v.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	if v.now().Sub(lastReload) >= minJWKSReloadInterval {
+		if err := v.reload(); err != nil {
+			authLogger.WithError(err).Error("failed to reload the JWKS file")
+		}
+		v.mu.RLock()
+		key, ok = v.keys[kid]
+		v.mu.RUnlock()
+		if ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// verify validates the signature and the registered claims of the token and
+// returns the identity it carries.
+func (v *jwtVerifier) verify(token string) (*Identity, error) {
+	tok, err := jwt.ParseSigned(token, signingAlgorithms)
+	if err != nil {
+		return nil, errors.Wrap(err, "malformed token")
+	}
+	key, err := v.key(tok.Headers[0].KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.Claims{}
+	rawClaims := map[string]json.RawMessage{}
+	if err = tok.Claims(key, &claims, &rawClaims); err != nil {
+		if err == jose.ErrCryptoFailure {
+			return nil, errors.New("invalid token signature")
+		}
+		return nil, errors.Wrap(err, "malformed token claims")
+	}
+
+	if claims.Expiry == nil {
+		return nil, errors.New("token has no expiration time")
+	}
+	expected := jwt.Expected{
+		Issuer: v.issuer,
+		Time:   v.now(),
+	}
+	if v.audience != "" {
+		expected.AnyAudience = jwt.Audience{v.audience}
+	}
+	switch err = claims.ValidateWithLeeway(expected, clockSkew); err {
+	case nil:
+	case jwt.ErrExpired:
+		return nil, errors.New("token is expired")
+	case jwt.ErrNotValidYet, jwt.ErrIssuedInTheFuture:
+		return nil, errors.New("token is not valid yet")
+	case jwt.ErrInvalidIssuer:
+		return nil, fmt.Errorf("unexpected token issuer %q", claims.Issuer)
+	case jwt.ErrInvalidAudience:
+		return nil, fmt.Errorf("token is not intended for audience %q", v.audience)
+	default:
+		return nil, errors.Wrap(err, "invalid token claims")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	roles, err := stringOrSlice(rawClaims[v.rolesClaim])
+	if err != nil {
+		return nil, errors.Wrapf(err, "malformed %s claim", v.rolesClaim)
+	}
+	return &Identity{Subject: claims.Subject, Roles: roles}, nil
+}
+
+// parseJWKS returns the signature verification keys of a JWK set by key id.
+// Only the RSA and the EC public keys are kept.
+func parseJWKS(data []byte) (map[string]interface{}, error) {
+	set := jose.JSONWebKeySet{}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		switch k.Key.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey:
+			keys[k.KeyID] = k.Key
+		}
+	}
+	return keys, nil
+}
+
+// stringOrSlice decodes a claim which is either a single string, possibly
+// space separated like the OAuth scope claim, or an array of strings.
+func stringOrSlice(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return strings.Fields(s), nil
+	}
+	var values []string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Unix(1600000000, 0)
+
+type testKey struct {
+	kid string
+	alg string
+	key crypto.Signer
+}
+
+func newRSATestKey(t *testing.T, kid string) *testKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return &testKey{kid: kid, alg: "RS256", key: key}
+}
+
+func newECTestKey(t *testing.T, kid string) *testKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &testKey{kid: kid, alg: "ES256", key: key}
+}
+
+func (k *testKey) jwk() map[string]string {
+	enc := base64.RawURLEncoding.EncodeToString
+	switch pub := k.key.Public().(type) {
+	case *rsa.PublicKey:
+		return map[string]string{
+			"kty": "RSA",
+			"kid": k.kid,
+			"use": "sig",
+			"n":   enc(pub.N.Bytes()),
+			"e":   enc(big.NewInt(int64(pub.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		return map[string]string{
+			"kty": "EC",
+			"kid": k.kid,
+			"crv": "P-256",
+			"x":   enc(padded(pub.X, 32)),
+			"y":   enc(padded(pub.Y, 32)),
+		}
+	}
+	return nil
+}
+
+func (k *testKey) sign(t *testing.T, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": k.alg, "kid": k.kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := crypto.SHA256.New()
+	digest.Write([]byte(signed))
+	var signature []byte
+	switch key := k.key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest.Sum(nil))
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest.Sum(nil))
+		require.NoError(t, err)
+		signature = append(padded(r, 32), padded(s, 32)...)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func padded(n *big.Int, size int) []byte {
+	b := n.Bytes()
+	return append(make([]byte, size-len(b)), b...)
+}
+
+func writeJWKS(t *testing.T, path string, keys ...*testKey) {
+	set := map[string][]map[string]string{"keys": {}}
+	for _, k := range keys {
+		set["keys"] = append(set["keys"], k.jwk())
+	}
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, data, 0644))
+}
+
+func newTestVerifier(t *testing.T, keys ...*testKey) (*jwtVerifier, string) {
+	dir, err := ioutil.TempDir("", "jwks")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "jwks.json")
+	writeJWKS(t, path, keys...)
+
+	cfg := viper.New()
+	cfg.Set(configNameJWKSFile, path)
+	cfg.Set(configNameIssuer, "https://issuer.example.com")
+	cfg.Set(configNameAudience, "open-match")
+
+	v, err := newJWTVerifier(cfg)
+	require.NoError(t, err)
+	v.now = func() time.Time { return testNow }
+	v.lastReload = testNow
+	return v, path
+}
+
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub":   "player-1",
+		"iss":   "https://issuer.example.com",
+		"aud":   []string{"open-match", "other"},
+		"exp":   testNow.Add(time.Hour).Unix(),
+		"nbf":   testNow.Add(-time.Minute).Unix(),
+		"roles": []string{"game-client"},
+	}
+}
+
+func TestJWTVerify(t *testing.T) {
+	rsaKey := newRSATestKey(t, "rsa")
+	ecKey := newECTestKey(t, "ec")
+	otherKey := newRSATestKey(t, "rsa")
+	v, _ := newTestVerifier(t, rsaKey, ecKey)
+
+	with := func(key string, value interface{}) map[string]interface{} {
+		claims := validClaims()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		description string
+		token       string
+		wantErr     string
+	}{
+		{"rsa", rsaKey.sign(t, validClaims()), ""},
+		{"ecdsa", ecKey.sign(t, validClaims()), ""},
+		{"single audience", rsaKey.sign(t, with("aud", "open-match")), ""},
+		{"expired within clock skew", rsaKey.sign(t, with("exp", testNow.Add(-10*time.Second).Unix())), ""},
+		{"expired", rsaKey.sign(t, with("exp", testNow.Add(-time.Minute).Unix())), "token is expired"},
+		{"no expiration", rsaKey.sign(t, with("exp", nil)), "token has no expiration time"},
+		{"not valid yet", rsaKey.sign(t, with("nbf", testNow.Add(time.Minute).Unix())), "token is not valid yet"},
+		{"wrong issuer", rsaKey.sign(t, with("iss", "https://evil.example.com")), "unexpected token issuer"},
+		{"wrong audience", rsaKey.sign(t, with("aud", "other")), "not intended for audience"},
+		{"no subject", rsaKey.sign(t, with("sub", nil)), "token has no subject"},
+		{"wrong signature", otherKey.sign(t, validClaims()), "invalid token signature"},
+		{"unknown key", newRSATestKey(t, "unknown").sign(t, validClaims()), "unknown signing key"},
+		{"symmetric algorithm", (&testKey{kid: "rsa", alg: "HS256", key: rsaKey.key}).sign(t, validClaims()), "unexpected signature algorithm"},
+		{"none algorithm", (&testKey{kid: "rsa", alg: "none", key: rsaKey.key}).sign(t, validClaims()), "unexpected signature algorithm"},
+		{"malformed", "not-a-token", "malformed token"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			id, err := v.verify(tt.token)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &Identity{Subject: "player-1", Roles: []string{"game-client"}}, id)
+		})
+	}
+}
+
+func TestJWTRolesClaim(t *testing.T) {
+	key := newRSATestKey(t, "rsa")
+	v, _ := newTestVerifier(t, key)
+	v.rolesClaim = "scope"
+
+	claims := validClaims()
+	claims["scope"] = "director admin"
+	id, err := v.verify(key.sign(t, claims))
+	require.NoError(t, err)
+	require.Equal(t, []string{"director", "admin"}, id.Roles)
+}
+
+func TestJWTReloadsRotatedKeys(t *testing.T) {
+	oldKey := newRSATestKey(t, "old")
+	newKey := newECTestKey(t, "new")
+	v, path := newTestVerifier(t, oldKey)
+
+	writeJWKS(t, path, oldKey, newKey)
+
+	// The file was just read, so the unknown key is not looked up again yet.
+	_, err := v.verify(newKey.sign(t, validClaims()))
+	require.Error(t, err)
+
+	now := testNow.Add(minJWKSReloadInterval)
+	v.now = func() time.Time { return now }
+	_, err = v.verify(newKey.sign(t, validClaims()))
+	require.NoError(t, err)
+}
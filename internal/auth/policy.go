@@ -0,0 +1,117 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+)
+
+const (
+	configNameProtectedServices = "api.auth.protectedServices"
+	configNameAnonymousRole     = "api.auth.anonymousRole"
+	configNameRoles             = "api.auth.roles"
+)
+
+// defaultProtectedServices are the public Open Match APIs.
+var defaultProtectedServices = []string{
+	"openmatch.FrontendService",
+	"openmatch.BackendService",
+	"openmatch.QueryService",
+	"openmatch.AdminService",
+}
+
+// policy grants the methods of the protected services to roles. Roles are
+// read from the configuration on every call, so the policy can be changed
+// without restarting:
+//
+//	api.auth.roles.<role>.permissions: full method names, "/<service>/*" or "*".
+//	api.auth.roles.<role>.ownTicketsOnly: restricts the ticket methods to the
+//	  Tickets created by the caller.
+type policy struct {
+	cfg config.View
+}
+
+// protects returns true if callers of the method must be authenticated.
+func (p *policy) protects(fullMethod string) bool {
+	services := defaultProtectedServices
+	if p.cfg.IsSet(configNameProtectedServices) {
+		services = p.cfg.GetStringSlice(configNameProtectedServices)
+	}
+
+	service := serviceName(fullMethod)
+	for _, s := range services {
+		if s == "*" || s == service {
+			return true
+		}
+	}
+	return false
+}
+
+// anonymousRole returns the role of unauthenticated callers, empty if they
+// must be rejected.
+func (p *policy) anonymousRole() string {
+	return p.cfg.GetString(configNameAnonymousRole)
+}
+
+// authorize returns the identity to call the method with, or a PermissionDenied
+// error if none of the roles of the caller grants the method. The caller is
+// restricted to its own Tickets only if every role granting the method is.
+func (p *policy) authorize(id *Identity, fullMethod string) (*Identity, error) {
+	granted := false
+	ownTicketsOnly := true
+	for _, role := range id.Roles {
+		prefix := configNameRoles + "." + role
+		if !permits(p.cfg.GetStringSlice(prefix+".permissions"), fullMethod) {
+			continue
+		}
+		granted = true
+		ownTicketsOnly = ownTicketsOnly && p.cfg.GetBool(prefix+".ownTicketsOnly")
+	}
+
+	if !granted {
+		return nil, status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", id.Subject, fullMethod)
+	}
+
+	return &Identity{
+		Subject:        id.Subject,
+		Roles:          id.Roles,
+		OwnTicketsOnly: ownTicketsOnly,
+	}, nil
+}
+
+func permits(permissions []string, fullMethod string) bool {
+	for _, permission := range permissions {
+		switch {
+		case permission == "*", permission == fullMethod:
+			return true
+		case strings.HasSuffix(permission, "/*") && strings.HasPrefix(fullMethod, strings.TrimSuffix(permission, "*")):
+			return true
+		}
+	}
+	return false
+}
+
+// serviceName returns the service of a full method name, "/<service>/<method>".
+func serviceName(fullMethod string) string {
+	name := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(name, "/"); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
@@ -0,0 +1,200 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package clock abstracts the time, so that the synchronizer cycles and the
+// expirations of the state storage can be driven by a fake clock in tests and
+// simulations.
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock tells the time and schedules functions, like the time package.
+type Clock interface {
+	Now() time.Time
+	Since(t time.Time) time.Duration
+	After(d time.Duration) <-chan time.Time
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a function scheduled with Clock.AfterFunc.
+type Timer interface {
+	// Stop prevents the function from running, and returns false if it already
+	// ran or was stopped.
+	Stop() bool
+}
+
+// Real is the wall clock.
+var Real Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Since(t time.Time) time.Duration {
+	return time.Since(t)
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+// Fake is a Clock whose time only moves when Advance is called. The channels
+// returned by After and the functions scheduled with AfterFunc are fired by
+// Advance, in the order of their deadlines.
+type Fake struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*waiter
+	added   chan struct{}
+}
+
+type waiter struct {
+	deadline time.Time
+	c        chan time.Time
+	f        func()
+	stopped  bool
+}
+
+// NewFake returns a Fake clock starting at now.
+func NewFake(now time.Time) *Fake {
+	return &Fake{
+		now:   now,
+		added: make(chan struct{}),
+	}
+}
+
+// Now returns the time of the clock.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Since returns the time elapsed on the clock since t.
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+// After returns a channel receiving the time of the clock once it advanced by
+// d.
+func (f *Fake) After(d time.Duration) <-chan time.Time {
+	c := make(chan time.Time, 1)
+	f.add(&waiter{c: c}, d)
+	return c
+}
+
+// AfterFunc calls fn once the clock advanced by d. fn is called by Advance.
+func (f *Fake) AfterFunc(d time.Duration, fn func()) Timer {
+	w := &waiter{f: fn}
+	f.add(w, d)
+	return &fakeTimer{clock: f, w: w}
+}
+
+func (f *Fake) add(w *waiter, d time.Duration) {
+	f.mu.Lock()
+	w.deadline = f.now.Add(d)
+	f.waiters = append(f.waiters, w)
+	close(f.added)
+	f.added = make(chan struct{})
+	f.mu.Unlock()
+}
+
+// Advance moves the clock forward by d, firing the waiters whose deadline
+// passed.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	f.now = f.now.Add(d)
+	now := f.now
+	var due, pending []*waiter
+	for _, w := range f.waiters {
+		if w.stopped {
+			continue
+		}
+		if w.deadline.After(now) {
+			pending = append(pending, w)
+		} else {
+			due = append(due, w)
+		}
+	}
+	f.waiters = pending
+	f.mu.Unlock()
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].deadline.Before(due[j].deadline)
+	})
+	for _, w := range due {
+		if w.c != nil {
+			w.c <- now
+		}
+		if w.f != nil {
+			w.f()
+		}
+	}
+}
+
+// Waiters returns the number of channels and functions waiting for the clock
+// to advance.
+func (f *Fake) Waiters() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	n := 0
+	for _, w := range f.waiters {
+		if !w.stopped {
+			n++
+		}
+	}
+	return n
+}
+
+// BlockUntil returns once at least n channels or functions are waiting for
+// the clock to advance. Tests use it to advance the clock only once the code
+// under test started waiting.
+func (f *Fake) BlockUntil(n int) {
+	for {
+		f.mu.Lock()
+		added := f.added
+		f.mu.Unlock()
+		if f.Waiters() >= n {
+			return
+		}
+		<-added
+	}
+}
+
+type fakeTimer struct {
+	clock *Fake
+	w     *waiter
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	for _, w := range t.clock.waiters {
+		if w == t.w && !w.stopped {
+			w.stopped = true
+			return true
+		}
+	}
+	return false
+}
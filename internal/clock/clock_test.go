@@ -0,0 +1,62 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Unix(1000, 0)
+	c := NewFake(start)
+	require.Equal(t, start, c.Now())
+
+	after := c.After(2 * time.Second)
+	var calls []string
+	c.AfterFunc(time.Second, func() { calls = append(calls, "1s") })
+	stopped := c.AfterFunc(time.Second, func() { calls = append(calls, "stopped") })
+	require.True(t, stopped.Stop())
+	require.False(t, stopped.Stop())
+	require.Equal(t, 2, c.Waiters())
+
+	c.Advance(time.Second)
+	require.Equal(t, []string{"1s"}, calls)
+	require.Equal(t, time.Second, c.Since(start))
+	select {
+	case <-after:
+		t.Fatal("After fired before its deadline")
+	default:
+	}
+
+	c.Advance(time.Second)
+	require.Equal(t, start.Add(2*time.Second), <-after)
+	require.Equal(t, 0, c.Waiters())
+}
+
+func TestFakeBlockUntil(t *testing.T) {
+	c := NewFake(time.Unix(0, 0))
+	done := make(chan struct{})
+	go func() {
+		<-c.After(time.Minute)
+		close(done)
+	}()
+
+	c.BlockUntil(1)
+	c.Advance(time.Minute)
+	<-done
+}
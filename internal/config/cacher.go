@@ -15,6 +15,7 @@
 package config
 
 import (
+	"reflect"
 	"sync"
 	"time"
 )
@@ -102,6 +103,7 @@ type viewChangeDetector struct {
 	getStringSlice map[string][]string
 	getBool        map[string]bool
 	getDuration    map[string]time.Duration
+	get            map[string]interface{}
 }
 
 func newViewChangeDetector(cfg View) *viewChangeDetector {
@@ -115,6 +117,7 @@ func newViewChangeDetector(cfg View) *viewChangeDetector {
 		getStringSlice: make(map[string][]string),
 		getBool:        make(map[string]bool),
 		getDuration:    make(map[string]time.Duration),
+		get:            make(map[string]interface{}),
 	}
 }
 
@@ -166,6 +169,12 @@ func (r *viewChangeDetector) GetDuration(k string) time.Duration {
 	return v
 }
 
+func (r *viewChangeDetector) Get(k string) interface{} {
+	v := r.cfg.Get(k)
+	r.get[k] = v
+	return v
+}
+
 func (r *viewChangeDetector) hasChanges() bool {
 	for k, v := range r.isSet {
 		if r.cfg.IsSet(k) != v {
@@ -222,5 +231,11 @@ func (r *viewChangeDetector) hasChanges() bool {
 		}
 	}
 
+	for k, v := range r.get {
+		if !reflect.DeepEqual(r.cfg.Get(k), v) {
+			return true
+		}
+	}
+
 	return false
 }
@@ -131,6 +131,16 @@ var getTests = []struct {
 			return cfg.GetDuration("foo")
 		},
 	},
+	{
+		name:           "Get",
+		firstValue:     []interface{}{map[string]interface{}{"name": "a"}},
+		firstExpected:  "a",
+		secondValue:    []interface{}{map[string]interface{}{"name": "b"}},
+		secondExpected: "b",
+		getValue: func(cfg View) interface{} {
+			return cfg.Get("foo").([]interface{})[0].(map[string]interface{})["name"]
+		},
+	},
 }
 
 //nolint: gocritic, staticcheck
@@ -17,14 +17,13 @@ package config
 
 import (
 	"fmt"
-	"log"
 
-	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
 )
 
 // Read sets default to a viper instance and read user config to override these defaults.
-func Read() (*viper.Viper, error) {
+// The returned View is reloaded when the override file changes.
+func Read() (*Watched, error) {
 	var err error
 	// read configs from config/default/matchmaker_config_default.yaml
 	// matchmaker_config_default provides default values for all of the possible tunnable parameters in Open Match
@@ -63,10 +62,6 @@ func Read() (*viper.Viper, error) {
 	// what the Open Match components using Viper monitor for changes.
 	// More details about Open Match's use of Kubernetes ConfigMaps at:
 	// https://open-match.dev/open-match/issues/42
-	cfg.WatchConfig() // Watch and re-read config file.
-	// Write a log when the configuration changes.
-	cfg.OnConfigChange(func(event fsnotify.Event) {
-		log.Printf("Server configuration changed, operation: %v, filename: %s", event.Op, event.Name)
-	})
-	return cfg, nil
+	// Changes are applied only if the components subscribed to them accept them.
+	return watch(cfg, dcfg.AllSettings()), nil
 }
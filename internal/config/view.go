@@ -31,6 +31,7 @@ type View interface {
 	GetStringSlice(string) []string
 	GetBool(string) bool
 	GetDuration(string) time.Duration
+	Get(string) interface{}
 }
 
 // Mutable is a read-write view of the Open Match configuration.
@@ -39,11 +40,14 @@ type Mutable interface {
 	View
 }
 
-// Sub returns a subset of configuration filtered by the key.
+// Sub returns a subset of configuration filtered by the key. The subset of a
+// Watched configuration is not reloaded.
 func Sub(v View, key string) View {
-	vcfg, ok := v.(*viper.Viper)
-	if ok {
+	switch vcfg := v.(type) {
+	case *viper.Viper:
 		return vcfg.Sub(key)
+	case *Watched:
+		return vcfg.current().Sub(key)
 	}
 	return nil
 }
@@ -0,0 +1,251 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+// ParseFunc reads the values a component needs from the configuration into
+// a typed value, and returns an error if they are not valid.
+type ParseFunc func(cfg View) (interface{}, error)
+
+// ApplyFunc makes a component use a value returned by its ParseFunc.
+type ApplyFunc func(v interface{})
+
+// Status describes the active version of a watched configuration.
+type Status struct {
+	// Version is incremented on every accepted reload, starting at 1.
+	Version int
+	// LoadedAt is the time the active version was loaded.
+	LoadedAt time.Time
+	// File is the path of the watched configuration file.
+	File string
+	// RejectedAt is the time of the last rejected reload, if any.
+	RejectedAt time.Time
+	// RejectedError is the reason the last reload was rejected.
+	RejectedError string
+}
+
+// Watched is the View of a configuration file which is reloaded when the file
+// changes. An accepted reload replaces the snapshot the View reads at once, so
+// readers see either the previous or the new configuration, never one being
+// modified.
+type Watched struct {
+	snapshot atomic.Value // *viper.Viper
+	watcher  *watcher
+}
+
+func (w *Watched) current() *viper.Viper {
+	return w.snapshot.Load().(*viper.Viper)
+}
+
+// IsSet implements View.
+func (w *Watched) IsSet(key string) bool { return w.current().IsSet(key) }
+
+// GetString implements View.
+func (w *Watched) GetString(key string) string { return w.current().GetString(key) }
+
+// GetInt implements View.
+func (w *Watched) GetInt(key string) int { return w.current().GetInt(key) }
+
+// GetInt64 implements View.
+func (w *Watched) GetInt64(key string) int64 { return w.current().GetInt64(key) }
+
+// GetFloat64 implements View.
+func (w *Watched) GetFloat64(key string) float64 { return w.current().GetFloat64(key) }
+
+// GetStringSlice implements View.
+func (w *Watched) GetStringSlice(key string) []string { return w.current().GetStringSlice(key) }
+
+// GetBool implements View.
+func (w *Watched) GetBool(key string) bool { return w.current().GetBool(key) }
+
+// GetDuration implements View.
+func (w *Watched) GetDuration(key string) time.Duration { return w.current().GetDuration(key) }
+
+// Get implements View.
+func (w *Watched) Get(key string) interface{} { return w.current().Get(key) }
+
+// AllSettings returns the settings of the active configuration.
+func (w *Watched) AllSettings() map[string]interface{} { return w.current().AllSettings() }
+
+// watcher reloads a configuration when its file changes. A reload is applied
+// only if all the subscribers accept the new configuration.
+type watcher struct {
+	live     *Watched
+	defaults map[string]interface{}
+	now      func() time.Time
+
+	mu            sync.Mutex
+	status        Status
+	subscriptions map[*subscription]struct{}
+}
+
+type subscription struct {
+	parse ParseFunc
+	apply ApplyFunc
+}
+
+// newWatched returns the View of cfg, whose file is reloaded by reload.
+func newWatched(cfg *viper.Viper, defaults map[string]interface{}) *Watched {
+	live := &Watched{}
+	live.snapshot.Store(cfg)
+	w := &watcher{
+		live:          live,
+		defaults:      defaults,
+		now:           time.Now,
+		subscriptions: make(map[*subscription]struct{}),
+	}
+	w.status = Status{
+		Version:  1,
+		LoadedAt: w.now(),
+		File:     cfg.ConfigFileUsed(),
+	}
+	live.watcher = w
+	return live
+}
+
+// watch returns the View of cfg, reloaded when its configuration file
+// changes. cfg itself is never modified.
+func watch(cfg *viper.Viper, defaults map[string]interface{}) *Watched {
+	live := newWatched(cfg, defaults)
+	w := live.watcher
+
+	trigger := viper.New()
+	trigger.SetConfigFile(w.status.File)
+	trigger.OnConfigChange(func(event fsnotify.Event) {
+		log.Printf("Server configuration changed, operation: %v, filename: %s", event.Op, event.Name)
+		data, err := ioutil.ReadFile(w.status.File)
+		if err != nil {
+			w.reject(errors.Wrap(err, "cannot read the configuration file"))
+			return
+		}
+		if err := w.reload(data); err != nil {
+			log.Printf("Server configuration change rejected: %s", err)
+		}
+	})
+	trigger.WatchConfig()
+	return live
+}
+
+// Subscribe calls parse with the configuration, and apply with the result.
+// If cfg is watched for changes, they are called again on every reload of the
+// configuration file: if any subscriber's parse fails, the reload is rejected
+// as a whole and the previous configuration stays active. apply may be nil
+// to only validate reloads, for components which read cfg on each use.
+//
+// The returned function cancels the subscription.
+func Subscribe(cfg View, parse ParseFunc, apply ApplyFunc) (func(), error) {
+	v, err := parse(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if apply != nil {
+		apply(v)
+	}
+
+	w, ok := watcherOf(cfg)
+	if !ok {
+		return func() {}, nil
+	}
+
+	s := &subscription{parse: parse, apply: apply}
+	w.mu.Lock()
+	w.subscriptions[s] = struct{}{}
+	w.mu.Unlock()
+
+	return func() {
+		w.mu.Lock()
+		delete(w.subscriptions, s)
+		w.mu.Unlock()
+	}, nil
+}
+
+// GetStatus returns the status of cfg, false if it is not watched for changes.
+func GetStatus(cfg View) (Status, bool) {
+	w, ok := watcherOf(cfg)
+	if !ok {
+		return Status{}, false
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.status, true
+}
+
+func watcherOf(cfg View) (*watcher, bool) {
+	live, ok := cfg.(*Watched)
+	if !ok {
+		return nil, false
+	}
+	return live.watcher, true
+}
+
+// reload validates data with the subscribers and, if they all accept it,
+// makes it the active configuration.
+func (w *watcher) reload(data []byte) error {
+	candidate := viper.New()
+	for k, v := range w.defaults {
+		candidate.SetDefault(k, v)
+	}
+	candidate.SetConfigType("yaml")
+	if err := candidate.ReadConfig(bytes.NewReader(data)); err != nil {
+		return w.reject(errors.Wrap(err, "cannot parse the configuration"))
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	parsed := make(map[*subscription]interface{}, len(w.subscriptions))
+	for s := range w.subscriptions {
+		v, err := s.parse(candidate)
+		if err != nil {
+			return w.rejectLocked(err)
+		}
+		parsed[s] = v
+	}
+
+	w.live.snapshot.Store(candidate)
+	w.status.Version++
+	w.status.LoadedAt = w.now()
+
+	for s, v := range parsed {
+		if s.apply != nil {
+			s.apply(v)
+		}
+	}
+	return nil
+}
+
+func (w *watcher) reject(err error) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.rejectLocked(err)
+}
+
+func (w *watcher) rejectLocked(err error) error {
+	w.status.RejectedAt = w.now()
+	w.status.RejectedError = err.Error()
+	return err
+}
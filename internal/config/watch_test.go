@@ -0,0 +1,84 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+func parsePositiveInterval(cfg View) (interface{}, error) {
+	interval := cfg.GetDuration("interval")
+	if interval <= 0 {
+		return nil, errors.New("interval must be positive")
+	}
+	return interval, nil
+}
+
+func TestSubscribe(t *testing.T) {
+	cfg := viper.New()
+	cfg.SetConfigType("yaml")
+	require.NoError(t, cfg.ReadConfig(strings.NewReader("interval: 1s")))
+	live := newWatched(cfg, map[string]interface{}{"other": "default"})
+	w := live.watcher
+
+	var interval time.Duration
+	unsubscribe, err := Subscribe(live, parsePositiveInterval, func(v interface{}) {
+		interval = v.(time.Duration)
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Second, interval)
+
+	require.NoError(t, w.reload([]byte("interval: 2s")))
+	require.Equal(t, 2*time.Second, interval)
+	require.Equal(t, "default", live.GetString("other"))
+	status, ok := GetStatus(live)
+	require.True(t, ok)
+	require.Equal(t, 2, status.Version)
+	require.Empty(t, status.RejectedError)
+
+	// Invalid reloads are rejected and the previous configuration stays active.
+	require.Error(t, w.reload([]byte("interval: -1s")))
+	require.Equal(t, 2*time.Second, interval)
+	require.Equal(t, 2*time.Second, live.GetDuration("interval"))
+	require.Error(t, w.reload([]byte("interval: [")))
+	status, _ = GetStatus(live)
+	require.Equal(t, 2, status.Version)
+	require.NotEmpty(t, status.RejectedError)
+
+	unsubscribe()
+	require.NoError(t, w.reload([]byte("interval: -1s")))
+	require.Equal(t, 2*time.Second, interval)
+	require.Equal(t, -time.Second, live.GetDuration("interval"))
+	// The configuration read initially is replaced, not modified.
+	require.Equal(t, time.Second, cfg.GetDuration("interval"))
+}
+
+func TestSubscribeNotWatched(t *testing.T) {
+	cfg := viper.New()
+	_, err := Subscribe(cfg, parsePositiveInterval, nil)
+	require.Error(t, err)
+
+	cfg.Set("interval", "1s")
+	_, err = Subscribe(cfg, parsePositiveInterval, nil)
+	require.NoError(t, err)
+	_, ok := GetStatus(cfg)
+	require.False(t, ok)
+}
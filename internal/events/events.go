@@ -0,0 +1,330 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package events publishes ticket lifecycle events to the sinks configured
+// in the events section of the Open Match configuration.
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/rs/xid"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"open-match.dev/open-match/internal/appmain"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	configNameBufferSize   = "events.bufferSize"
+	configNameBatchSize    = "events.batchSize"
+	configNameCloseTimeout = "events.closeTimeout"
+
+	defaultBufferSize   = 1000
+	defaultBatchSize    = 100
+	defaultCloseTimeout = 10 * time.Second
+)
+
+var (
+	logger = logrus.WithFields(logrus.Fields{
+		"app":       "openmatch",
+		"component": "events",
+	})
+
+	keySink = tag.MustNewKey("sink")
+
+	eventsPublished = stats.Int64("open-match.dev/events/published", "Number of ticket events delivered to a sink", stats.UnitDimensionless)
+	eventsDropped   = stats.Int64("open-match.dev/events/dropped", "Number of ticket events dropped because the sink buffer was full", stats.UnitDimensionless)
+	eventsFailed    = stats.Int64("open-match.dev/events/failed", "Number of ticket events which could not be delivered to a sink", stats.UnitDimensionless)
+
+	eventsPublishedView = &view.View{
+		Measure:     eventsPublished,
+		Name:        "open-match.dev/events/published",
+		Description: "Number of ticket events delivered to a sink",
+		Aggregation: view.Sum(),
+		TagKeys:     []tag.Key{keySink},
+	}
+	eventsDroppedView = &view.View{
+		Measure:     eventsDropped,
+		Name:        "open-match.dev/events/dropped",
+		Description: "Number of ticket events dropped because the sink buffer was full",
+		Aggregation: view.Sum(),
+		TagKeys:     []tag.Key{keySink},
+	}
+	eventsFailedView = &view.View{
+		Measure:     eventsFailed,
+		Name:        "open-match.dev/events/failed",
+		Description: "Number of ticket events which could not be delivered to a sink",
+		Aggregation: view.Sum(),
+		TagKeys:     []tag.Key{keySink},
+	}
+)
+
+// Sink delivers batches of ticket events to an external system.
+type Sink interface {
+	// Name identifies the sink in logs and metrics.
+	Name() string
+	// Send delivers the events, retrying as configured for the sink.
+	Send(ctx context.Context, events []*pb.TicketEvent) error
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+// Publisher asynchronously fans out ticket events to all configured sinks.
+// Publishing never blocks the caller: events are dropped when the buffer of
+// a sink is full. A nil Publisher discards all events.
+type Publisher struct {
+	sinks        []*sinkWorker
+	batchSize    int
+	closeTimeout time.Duration
+	// cancel aborts the deliveries still running when Close times out.
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+type sinkWorker struct {
+	sink   Sink
+	events chan *pb.TicketEvent
+	ctx    context.Context
+}
+
+// BindPublisher creates a Publisher from the configuration and binds it to the
+// serving harness, so it is closed when the application stops.
+func BindPublisher(p *appmain.Params, b *appmain.Bindings) (*Publisher, error) {
+	publisher, err := New(p.Config())
+	if err != nil {
+		return nil, err
+	}
+
+	b.AddCloser(publisher.Close)
+	b.RegisterViews(
+		eventsPublishedView,
+		eventsDroppedView,
+		eventsFailedView,
+	)
+	return publisher, nil
+}
+
+// New creates a Publisher for the sinks enabled in the configuration.
+func New(cfg config.View) (*Publisher, error) {
+	sinks, err := sinksFromConfig(cfg)
+	if err != nil {
+		for _, s := range sinks {
+			s.Close()
+		}
+		return nil, err
+	}
+
+	return NewWithSinks(cfg, sinks...), nil
+}
+
+// NewWithSinks creates a Publisher for the given sinks.
+func NewWithSinks(cfg config.View, sinks ...Sink) *Publisher {
+	bufferSize := defaultBufferSize
+	if cfg.IsSet(configNameBufferSize) {
+		bufferSize = cfg.GetInt(configNameBufferSize)
+	}
+	batchSize := defaultBatchSize
+	if cfg.IsSet(configNameBatchSize) {
+		batchSize = cfg.GetInt(configNameBatchSize)
+	}
+
+	closeTimeout := defaultCloseTimeout
+	if cfg.IsSet(configNameCloseTimeout) {
+		closeTimeout = cfg.GetDuration(configNameCloseTimeout)
+	}
+
+	base, cancel := context.WithCancel(context.Background())
+	p := &Publisher{
+		batchSize:    batchSize,
+		closeTimeout: closeTimeout,
+		cancel:       cancel,
+	}
+
+	for _, s := range sinks {
+		ctx, err := tag.New(base, tag.Insert(keySink, s.Name()))
+		if err != nil {
+			logger.WithError(err).Errorf("failed to tag metrics of sink %s", s.Name())
+			ctx = base
+		}
+
+		w := &sinkWorker{
+			sink:   s,
+			events: make(chan *pb.TicketEvent, bufferSize),
+			ctx:    ctx,
+		}
+		p.sinks = append(p.sinks, w)
+
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			w.run(p.batchSize)
+		}()
+	}
+
+	return p
+}
+
+// Enabled returns true if events are delivered to at least one sink.
+func (p *Publisher) Enabled() bool {
+	return p != nil && len(p.sinks) > 0
+}
+
+// Publish queues an event of the given type for each of the tickets.
+func (p *Publisher) Publish(eventType pb.TicketEvent_Type, tickets ...*pb.Ticket) {
+	if !p.Enabled() {
+		return
+	}
+
+	for _, t := range tickets {
+		p.send(newEvent(eventType, t.GetId(), t, ""))
+	}
+}
+
+// PublishIDs queues an event of the given type for each of the ticket ids,
+// for operations which do not read or write the tickets themselves.
+func (p *Publisher) PublishIDs(eventType pb.TicketEvent_Type, ids ...string) {
+	if !p.Enabled() {
+		return
+	}
+
+	for _, id := range ids {
+		p.send(newEvent(eventType, id, nil, ""))
+	}
+}
+
+// PublishProposed queues a PROPOSED event for each of the tickets in the match.
+func (p *Publisher) PublishProposed(match *pb.Match) {
+	if !p.Enabled() {
+		return
+	}
+
+	for _, t := range match.GetTickets() {
+		p.send(newEvent(pb.TicketEvent_PROPOSED, t.GetId(), t, match.GetMatchId()))
+	}
+}
+
+// Close stops accepting events, delivers the buffered ones and closes the
+// sinks. The deliveries are canceled and the events still buffered are
+// dropped after events.closeTimeout, so that a slow sink can't hold the
+// shutdown.
+func (p *Publisher) Close() {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	for _, w := range p.sinks {
+		close(w.events)
+	}
+	p.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(p.closeTimeout):
+		logger.Warningf("failed to deliver the buffered events within %s, dropping them", p.closeTimeout)
+		p.cancel()
+		// The sinks return as soon as their context is canceled.
+		<-done
+	}
+	p.cancel()
+
+	for _, w := range p.sinks {
+		if err := w.sink.Close(); err != nil {
+			logger.WithError(err).Errorf("failed to close event sink %s", w.sink.Name())
+		}
+	}
+}
+
+func (p *Publisher) send(event *pb.TicketEvent) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return
+	}
+
+	for _, w := range p.sinks {
+		select {
+		case w.events <- event:
+		default:
+			stats.Record(w.ctx, eventsDropped.M(1))
+		}
+	}
+}
+
+func newEvent(eventType pb.TicketEvent_Type, id string, ticket *pb.Ticket, matchID string) *pb.TicketEvent {
+	return &pb.TicketEvent{
+		Id:        xid.New().String(),
+		Type:      eventType,
+		TicketId:  id,
+		Ticket:    ticket,
+		MatchId:   matchID,
+		EventTime: ptypes.TimestampNow(),
+	}
+}
+
+// run delivers the events of the worker in batches until its channel is
+// closed. Once its context is canceled, the remaining events are dropped.
+func (w *sinkWorker) run(batchSize int) {
+	for event := range w.events {
+		if w.ctx.Err() != nil {
+			stats.Record(w.ctx, eventsDropped.M(1))
+			continue
+		}
+		batch := []*pb.TicketEvent{event}
+
+	collect:
+		for len(batch) < batchSize {
+			select {
+			case e, ok := <-w.events:
+				if !ok {
+					break collect
+				}
+				batch = append(batch, e)
+			default:
+				break collect
+			}
+		}
+
+		if err := w.sink.Send(w.ctx, batch); err != nil {
+			logger.WithFields(logrus.Fields{
+				"error":  err.Error(),
+				"sink":   w.sink.Name(),
+				"events": len(batch),
+			}).Error("failed to deliver ticket events")
+			stats.Record(w.ctx, eventsFailed.M(int64(len(batch))))
+			continue
+		}
+		stats.Record(w.ctx, eventsPublished.M(int64(len(batch))))
+	}
+}
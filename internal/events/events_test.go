@@ -0,0 +1,165 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+type fakeSink struct {
+	mu      sync.Mutex
+	batches [][]*pb.TicketEvent
+	block   chan struct{}
+	closed  bool
+}
+
+func (s *fakeSink) Name() string {
+	return "fake"
+}
+
+func (s *fakeSink) Send(ctx context.Context, events []*pb.TicketEvent) error {
+	if s.block != nil {
+		select {
+		case <-s.block:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.batches = append(s.batches, events)
+	return nil
+}
+
+func (s *fakeSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *fakeSink) events() []*pb.TicketEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var r []*pb.TicketEvent
+	for _, b := range s.batches {
+		r = append(r, b...)
+	}
+	return r
+}
+
+func TestPublish(t *testing.T) {
+	require := require.New(t)
+
+	sink := &fakeSink{}
+	p := NewWithSinks(viper.New(), sink)
+	require.True(p.Enabled())
+
+	ticket := &pb.Ticket{Id: "1"}
+	p.Publish(pb.TicketEvent_CREATED, ticket)
+	p.PublishIDs(pb.TicketEvent_DELETED, "2", "3")
+	p.PublishProposed(&pb.Match{MatchId: "m", Tickets: []*pb.Ticket{ticket}})
+	p.Close()
+
+	events := sink.events()
+	require.Len(events, 4)
+	require.True(sink.closed)
+
+	require.Equal(pb.TicketEvent_CREATED, events[0].Type)
+	require.Equal("1", events[0].TicketId)
+	require.Equal(ticket, events[0].Ticket)
+	require.NotEmpty(events[0].Id)
+	require.NotNil(events[0].EventTime)
+
+	require.Equal(pb.TicketEvent_DELETED, events[1].Type)
+	require.Equal("2", events[1].TicketId)
+	require.Nil(events[1].Ticket)
+	require.Equal("3", events[2].TicketId)
+
+	require.Equal(pb.TicketEvent_PROPOSED, events[3].Type)
+	require.Equal("m", events[3].MatchId)
+	require.Equal(ticket, events[3].Ticket)
+
+	// Events published after Close are discarded.
+	p.PublishIDs(pb.TicketEvent_DELETED, "4")
+	p.Close()
+	require.Len(sink.events(), 4)
+}
+
+func TestPublishBatchesAndDrops(t *testing.T) {
+	require := require.New(t)
+
+	cfg := viper.New()
+	cfg.Set(configNameBufferSize, 3)
+	cfg.Set(configNameBatchSize, 2)
+
+	sink := &fakeSink{block: make(chan struct{})}
+	p := NewWithSinks(cfg, sink)
+
+	// The worker takes the first event and blocks sending it, three more fit
+	// in the buffer and the remaining ones are dropped.
+	p.PublishIDs(pb.TicketEvent_CREATED, "1")
+	require.Eventually(func() bool {
+		return len(p.sinks[0].events) == 0
+	}, time.Second, time.Millisecond)
+	p.PublishIDs(pb.TicketEvent_CREATED, "2", "3", "4", "5", "6")
+
+	close(sink.block)
+	p.Close()
+
+	require.Len(sink.events(), 4)
+	for _, b := range sink.batches {
+		require.LessOrEqual(len(b), 2)
+	}
+}
+
+func TestCloseTimeout(t *testing.T) {
+	require := require.New(t)
+
+	cfg := viper.New()
+	cfg.Set(configNameCloseTimeout, 10*time.Millisecond)
+	sink := &fakeSink{block: make(chan struct{})}
+	p := NewWithSinks(cfg, sink)
+
+	p.PublishIDs(pb.TicketEvent_CREATED, "1", "2", "3")
+	// The sink never delivers the events, Close gives up after the timeout.
+	p.Close()
+	require.Empty(sink.events())
+	require.True(sink.closed)
+}
+
+func TestNilPublisher(t *testing.T) {
+	var p *Publisher
+	require.False(t, p.Enabled())
+	p.Publish(pb.TicketEvent_CREATED, &pb.Ticket{Id: "1"})
+	p.PublishIDs(pb.TicketEvent_DELETED, "1")
+	p.PublishProposed(&pb.Match{Tickets: []*pb.Ticket{{Id: "1"}}})
+	p.Close()
+}
+
+func TestNewWithoutSinks(t *testing.T) {
+	p, err := New(viper.New())
+	require.NoError(t, err)
+	require.False(t, p.Enabled())
+	p.PublishIDs(pb.TicketEvent_DELETED, "1")
+	p.Close()
+}
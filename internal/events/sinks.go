@@ -0,0 +1,303 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	// SignatureHeader is the HTTP header carrying the HMAC-SHA256 signature of
+	// the webhook request body, formatted as "sha256=<hex digest>".
+	SignatureHeader = "X-Open-Match-Signature"
+
+	defaultSinkTimeout    = 5 * time.Second
+	defaultSinkMaxRetries = 3
+)
+
+// sinksFromConfig creates the sinks enabled under the events configuration.
+func sinksFromConfig(cfg config.View) ([]Sink, error) {
+	sinks := []Sink{}
+
+	if cfg.GetBool("events.webhook.enable") {
+		s, err := newWebhookSink(cfg)
+		if err != nil {
+			return sinks, err
+		}
+		sinks = append(sinks, s)
+	}
+
+	if cfg.GetBool("events.grpc.enable") {
+		s, err := newGRPCSink(cfg)
+		if err != nil {
+			return sinks, err
+		}
+		sinks = append(sinks, s)
+	}
+
+	if cfg.GetBool("events.file.enable") {
+		s, err := newFileSink(cfg.GetString("events.file.path"))
+		if err != nil {
+			return sinks, err
+		}
+		sinks = append(sinks, s)
+	}
+
+	return sinks, nil
+}
+
+func sinkTimeout(cfg config.View, prefix string) time.Duration {
+	if cfg.IsSet(prefix + ".timeout") {
+		return cfg.GetDuration(prefix + ".timeout")
+	}
+	return defaultSinkTimeout
+}
+
+// newRetryBackoff returns the exponential backoff strategy configured for Open
+// Match, limited to the maximum number of retries of the sink.
+func newRetryBackoff(ctx context.Context, cfg config.View, prefix string) backoff.BackOff {
+	maxRetries := defaultSinkMaxRetries
+	if cfg.IsSet(prefix + ".maxRetries") {
+		maxRetries = cfg.GetInt(prefix + ".maxRetries")
+	}
+
+	b := backoff.NewExponentialBackOff()
+	if cfg.IsSet("backoff.initialInterval") {
+		b.InitialInterval = cfg.GetDuration("backoff.initialInterval")
+		b.RandomizationFactor = cfg.GetFloat64("backoff.randFactor")
+		b.Multiplier = cfg.GetFloat64("backoff.multiplier")
+		b.MaxInterval = cfg.GetDuration("backoff.maxInterval")
+		b.MaxElapsedTime = cfg.GetDuration("backoff.maxElapsedTime")
+	}
+	return backoff.WithContext(backoff.WithMaxRetries(b, uint64(maxRetries)), ctx)
+}
+
+func marshalEvents(events []*pb.TicketEvent) ([]byte, error) {
+	var buf bytes.Buffer
+	m := &jsonpb.Marshaler{}
+	if err := m.Marshal(&buf, &pb.PublishTicketEventsRequest{Events: events}); err != nil {
+		return nil, errors.Wrap(err, "failed to marshal ticket events")
+	}
+	return buf.Bytes(), nil
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// webhookSink posts batches of events as JSON encoded PublishTicketEventsRequests.
+type webhookSink struct {
+	cfg    config.View
+	url    string
+	secret []byte
+	client *http.Client
+}
+
+func newWebhookSink(cfg config.View) (*webhookSink, error) {
+	url := cfg.GetString("events.webhook.url")
+	if url == "" {
+		return nil, errors.New("events.webhook.url is required when the webhook sink is enabled")
+	}
+
+	s := &webhookSink{
+		cfg:    cfg,
+		url:    url,
+		client: &http.Client{Timeout: sinkTimeout(cfg, "events.webhook")},
+	}
+
+	if path := cfg.GetString("events.webhook.hmacSecretPath"); path != "" {
+		secret, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the webhook hmac secret from %s", path)
+		}
+		s.secret = bytes.TrimSpace(secret)
+	}
+
+	return s, nil
+}
+
+func (s *webhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *webhookSink) Send(ctx context.Context, events []*pb.TicketEvent) error {
+	body, err := marshalEvents(events)
+	if err != nil {
+		return err
+	}
+
+	return backoff.Retry(func() error {
+		return s.post(ctx, body)
+	}, newRetryBackoff(ctx, s.cfg, "events.webhook"))
+}
+
+func (s *webhookSink) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return backoff.Permanent(errors.Wrap(err, "failed to create webhook request"))
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	if len(s.secret) > 0 {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(s.secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post ticket events to webhook")
+	}
+	defer resp.Body.Close()
+	// Drain the body so the connection can be reused.
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	err = fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return err
+	}
+	return backoff.Permanent(err)
+}
+
+func (s *webhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of the body, as set in the
+// SignatureHeader of webhook requests.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// grpcSink delivers batches of events to an EventSink service.
+type grpcSink struct {
+	cfg     config.View
+	conn    *grpc.ClientConn
+	client  pb.EventSinkClient
+	timeout time.Duration
+}
+
+func newGRPCSink(cfg config.View) (*grpcSink, error) {
+	conn, err := rpc.GRPCClientFromConfig(cfg, "events.grpc")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to the grpc event sink")
+	}
+
+	return &grpcSink{
+		cfg:     cfg,
+		conn:    conn,
+		client:  pb.NewEventSinkClient(conn),
+		timeout: sinkTimeout(cfg, "events.grpc"),
+	}, nil
+}
+
+func (s *grpcSink) Name() string {
+	return "grpc"
+}
+
+func (s *grpcSink) Send(ctx context.Context, events []*pb.TicketEvent) error {
+	req := &pb.PublishTicketEventsRequest{Events: events}
+	return backoff.Retry(func() error {
+		ctx, cancel := context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+		_, err := s.client.PublishTicketEvents(ctx, req)
+		return err
+	}, newRetryBackoff(ctx, s.cfg, "events.grpc"))
+}
+
+func (s *grpcSink) Close() error {
+	return s.conn.Close()
+}
+
+///////////////////////////////////////
+///////////////////////////////////////
+
+// fileSink appends events to a file, one JSON encoded TicketEvent per line.
+// It is intended for local testing.
+type fileSink struct {
+	mu sync.Mutex
+	f  *os.File
+	m  *jsonpb.Marshaler
+}
+
+func newFileSink(path string) (*fileSink, error) {
+	if path == "" {
+		return nil, errors.New("events.file.path is required when the file sink is enabled")
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open the event file %s", path)
+	}
+
+	return &fileSink{
+		f: f,
+		m: &jsonpb.Marshaler{},
+	}, nil
+}
+
+func (s *fileSink) Name() string {
+	return "file"
+}
+
+func (s *fileSink) Send(ctx context.Context, events []*pb.TicketEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range events {
+		var line bytes.Buffer
+		if err := s.m.Marshal(&line, e); err != nil {
+			return errors.Wrap(err, "failed to marshal ticket event")
+		}
+		line.WriteByte('\n')
+		// Write each line at once, so that services sharing the file don't interleave events.
+		if _, err := s.f.Write(line.Bytes()); err != nil {
+			return errors.Wrap(err, "failed to write ticket event")
+		}
+	}
+	return nil
+}
+
+func (s *fileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Close()
+}
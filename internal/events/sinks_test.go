@@ -0,0 +1,161 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func newTestConfig() *viper.Viper {
+	cfg := viper.New()
+	cfg.Set("backoff.initialInterval", time.Millisecond)
+	cfg.Set("backoff.randFactor", 0.5)
+	cfg.Set("backoff.multiplier", 1.5)
+	cfg.Set("backoff.maxInterval", 10*time.Millisecond)
+	cfg.Set("backoff.maxElapsedTime", time.Second)
+	return cfg
+}
+
+func TestWebhookSink(t *testing.T) {
+	require := require.New(t)
+
+	secretFile, err := ioutil.TempFile("", "webhook-secret")
+	require.NoError(err)
+	defer os.Remove(secretFile.Name())
+	_, err = secretFile.WriteString("secret\n")
+	require.NoError(err)
+	require.NoError(secretFile.Close())
+
+	calls := 0
+	var body []byte
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		// The first attempt fails and must be retried.
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		header = r.Header
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	cfg := newTestConfig()
+	cfg.Set("events.webhook.enable", true)
+	cfg.Set("events.webhook.url", server.URL)
+	cfg.Set("events.webhook.hmacSecretPath", secretFile.Name())
+
+	sinks, err := sinksFromConfig(cfg)
+	require.NoError(err)
+	require.Len(sinks, 1)
+	defer sinks[0].Close()
+
+	events := []*pb.TicketEvent{{Id: "e", Type: pb.TicketEvent_ASSIGNED, TicketId: "1"}}
+	require.NoError(sinks[0].Send(context.Background(), events))
+	require.Equal(2, calls)
+	require.Equal("application/json", header.Get("Content-Type"))
+	require.Equal("sha256="+Sign([]byte("secret"), body), header.Get(SignatureHeader))
+
+	received := &pb.PublishTicketEventsRequest{}
+	require.NoError(jsonpb.UnmarshalString(string(body), received))
+	require.Len(received.Events, 1)
+	require.Equal(pb.TicketEvent_ASSIGNED, received.Events[0].Type)
+	require.Equal("1", received.Events[0].TicketId)
+}
+
+func TestWebhookSinkPermanentError(t *testing.T) {
+	require := require.New(t)
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	cfg := newTestConfig()
+	cfg.Set("events.webhook.url", server.URL)
+	s, err := newWebhookSink(cfg)
+	require.NoError(err)
+	defer s.Close()
+
+	err = s.Send(context.Background(), []*pb.TicketEvent{{Id: "e"}})
+	require.Error(err)
+	require.Contains(err.Error(), "webhook responded with status 400")
+	// Client errors are not retried.
+	require.Equal(1, calls)
+}
+
+func TestFileSink(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "events")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "events.jsonl")
+
+	cfg := viper.New()
+	cfg.Set("events.file.enable", true)
+	cfg.Set("events.file.path", path)
+
+	p, err := New(cfg)
+	require.NoError(err)
+	require.True(p.Enabled())
+	p.PublishIDs(pb.TicketEvent_EXPIRED, "1", "2")
+	p.Close()
+
+	f, err := os.Open(path)
+	require.NoError(err)
+	defer f.Close()
+
+	var ids []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		e := &pb.TicketEvent{}
+		require.NoError(jsonpb.UnmarshalString(scanner.Text(), e))
+		require.Equal(pb.TicketEvent_EXPIRED, e.Type)
+		ids = append(ids, e.TicketId)
+	}
+	require.NoError(scanner.Err())
+	require.Equal([]string{"1", "2"}, ids)
+}
+
+func TestSinksFromConfigErrors(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("events.webhook.enable", true)
+	_, err := New(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "events.webhook.url is required")
+
+	cfg = viper.New()
+	cfg.Set("events.file.enable", true)
+	_, err = New(cfg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "events.file.path is required")
+}
@@ -18,6 +18,8 @@
 package filter
 
 import (
+	"fmt"
+	"math"
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
@@ -45,10 +47,19 @@ type PoolFilter struct {
 	TagPresentFilters   []*pb.TagPresentFilter
 	CreatedBefore       time.Time
 	CreatedAfter        time.Time
+
+	// now is the time relaxed DoubleRangeFilters are evaluated against.
+	now time.Time
 }
 
 // NewPoolFilter validates a Pool's filtering criteria and returns a PoolFilter.
 func NewPoolFilter(pool *pb.Pool) (*PoolFilter, error) {
+	return NewPoolFilterAt(pool, time.Now())
+}
+
+// NewPoolFilterAt is NewPoolFilter, with the relaxed DoubleRangeFilters
+// evaluated at now.
+func NewPoolFilterAt(pool *pb.Pool, now time.Time) (*PoolFilter, error) {
 	var ca, cb time.Time
 	var err error
 
@@ -64,15 +75,28 @@ func NewPoolFilter(pool *pb.Pool) (*PoolFilter, error) {
 		}
 	}
 
+	for _, f := range pool.GetDoubleRangeFilters() {
+		if r := f.GetRelaxation(); r != nil {
+			if !validRelaxationValue(r.MinPerSecond) || !validRelaxationValue(r.MaxPerSecond) || !validRelaxationValue(r.Limit) {
+				return nil, status.Errorf(codes.InvalidArgument, ".invalid relaxation value for double_arg %s", f.DoubleArg)
+			}
+		}
+	}
+
 	return &PoolFilter{
 		DoubleRangeFilters:  pool.GetDoubleRangeFilters(),
 		StringEqualsFilters: pool.GetStringEqualsFilters(),
 		TagPresentFilters:   pool.GetTagPresentFilters(),
 		CreatedBefore:       cb,
 		CreatedAfter:        ca,
+		now:                 now,
 	}, nil
 }
 
+func validRelaxationValue(v float64) bool {
+	return v >= 0 && !math.IsInf(v, 1)
+}
+
 type filteredEntity interface {
 	GetId() string
 	GetSearchFields() *pb.SearchFields
@@ -81,6 +105,78 @@ type filteredEntity interface {
 
 // In returns true if the Ticket meets all the criteria for this PoolFilter.
 func (pf *PoolFilter) In(entity filteredEntity) bool {
+	return pf.check(entity).kind == filterNone
+}
+
+// Rejection explains why an entity is not in a PoolFilter.
+type Rejection struct {
+	// Filter is the failed filter, as its field of the Pool and its index,
+	// e.g. "double_range_filters[1]".
+	Filter string
+	// Reason describes why the entity does not pass the filter.
+	Reason string
+}
+
+// Explain returns why the entity is not in this PoolFilter, or nil if it is.
+// Only the first failed filter is returned, in the order In checks them.
+func (pf *PoolFilter) Explain(entity filteredEntity) *Rejection {
+	f := pf.check(entity)
+	s := entity.GetSearchFields()
+
+	switch f.kind {
+	case filterNone:
+		return nil
+	case filterCreatedAfter:
+		return &Rejection{Filter: "created_after", Reason: fmt.Sprintf("created at %s, not after %s", f.created.Format(time.RFC3339Nano), pf.CreatedAfter.Format(time.RFC3339Nano))}
+	case filterCreatedBefore:
+		return &Rejection{Filter: "created_before", Reason: fmt.Sprintf("created at %s, not before %s", f.created.Format(time.RFC3339Nano), pf.CreatedBefore.Format(time.RFC3339Nano))}
+	case filterDoubleRange:
+		d := pf.DoubleRangeFilters[f.index]
+		r := &Rejection{Filter: fmt.Sprintf("double_range_filters[%d]", f.index)}
+		if v, ok := s.GetDoubleArgs()[d.DoubleArg]; ok {
+			r.Reason = fmt.Sprintf("double arg %q is %v, not in [%v, %v] excluding %s", d.DoubleArg, v, f.min, f.max, d.Exclude)
+		} else {
+			r.Reason = fmt.Sprintf("double arg %q is missing", d.DoubleArg)
+		}
+		return r
+	case filterStringEquals:
+		e := pf.StringEqualsFilters[f.index]
+		r := &Rejection{Filter: fmt.Sprintf("string_equals_filters[%d]", f.index)}
+		if v, ok := s.GetStringArgs()[e.StringArg]; ok {
+			r.Reason = fmt.Sprintf("string arg %q is %q, not %q", e.StringArg, v, e.Value)
+		} else {
+			r.Reason = fmt.Sprintf("string arg %q is missing", e.StringArg)
+		}
+		return r
+	default:
+		return &Rejection{
+			Filter: fmt.Sprintf("tag_present_filters[%d]", f.index),
+			Reason: fmt.Sprintf("tag %q is missing", pf.TagPresentFilters[f.index].Tag),
+		}
+	}
+}
+
+type filterKind int
+
+const (
+	filterNone filterKind = iota
+	filterCreatedAfter
+	filterCreatedBefore
+	filterDoubleRange
+	filterStringEquals
+	filterTagPresent
+)
+
+// failedFilter identifies the first filter an entity failed, with the values
+// it was checked against, so that In does not pay for the explanation.
+type failedFilter struct {
+	kind     filterKind
+	index    int
+	created  time.Time
+	min, max float64
+}
+
+func (pf *PoolFilter) check(entity filteredEntity) failedFilter {
 	s := entity.GetSearchFields()
 
 	if s == nil {
@@ -92,13 +188,13 @@ func (pf *PoolFilter) In(entity filteredEntity) bool {
 		if ct, err := ptypes.Timestamp(entity.GetCreateTime()); err == nil {
 			if !pf.CreatedAfter.IsZero() {
 				if !ct.After(pf.CreatedAfter) {
-					return false
+					return failedFilter{kind: filterCreatedAfter, created: ct}
 				}
 			}
 
 			if !pf.CreatedBefore.IsZero() {
 				if !ct.Before(pf.CreatedBefore) {
-					return false
+					return failedFilter{kind: filterCreatedBefore, created: ct}
 				}
 			}
 		} else {
@@ -109,53 +205,81 @@ func (pf *PoolFilter) In(entity filteredEntity) bool {
 		}
 	}
 
-	for _, f := range pf.DoubleRangeFilters {
+	for i, f := range pf.DoubleRangeFilters {
+		min, max := f.Min, f.Max
 		v, ok := s.DoubleArgs[f.DoubleArg]
 		if !ok {
-			return false
+			return failedFilter{kind: filterDoubleRange, index: i, min: min, max: max}
 		}
 
+		if f.Relaxation != nil {
+			min, max = pf.relax(f, entity)
+		}
+
+		in := true
 		switch f.Exclude {
 		case pb.DoubleRangeFilter_NONE:
 			// Not simplified so that NaN cases are handled correctly.
-			if !(v >= f.Min && v <= f.Max) {
-				return false
-			}
+			in = v >= min && v <= max
 		case pb.DoubleRangeFilter_MIN:
-			if !(v > f.Min && v <= f.Max) {
-				return false
-			}
+			in = v > min && v <= max
 		case pb.DoubleRangeFilter_MAX:
-			if !(v >= f.Min && v < f.Max) {
-				return false
-			}
+			in = v >= min && v < max
 		case pb.DoubleRangeFilter_BOTH:
-			if !(v > f.Min && v < f.Max) {
-				return false
-			}
+			in = v > min && v < max
+		}
+		if !in {
+			return failedFilter{kind: filterDoubleRange, index: i, min: min, max: max}
 		}
-
 	}
 
-	for _, f := range pf.StringEqualsFilters {
+	for i, f := range pf.StringEqualsFilters {
 		v, ok := s.StringArgs[f.StringArg]
 		if !ok {
-			return false
+			return failedFilter{kind: filterStringEquals, index: i}
 		}
 		if f.Value != v {
-			return false
+			return failedFilter{kind: filterStringEquals, index: i}
 		}
 	}
 
 outer:
-	for _, f := range pf.TagPresentFilters {
+	for i, f := range pf.TagPresentFilters {
 		for _, v := range s.Tags {
 			if v == f.Tag {
 				continue outer
 			}
 		}
-		return false
+		return failedFilter{kind: filterTagPresent, index: i}
+	}
+
+	return failedFilter{kind: filterNone}
+}
+
+// relax returns the bounds of a DoubleRangeFilter widened by its Relaxation,
+// based on how long the entity has been waiting since its CreateTime.
+func (pf *PoolFilter) relax(f *pb.DoubleRangeFilter, entity filteredEntity) (float64, float64) {
+	ct, err := ptypes.Timestamp(entity.GetCreateTime())
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"error": err.Error(),
+			"id":    entity.GetId(),
+		}).Error("failed to get time from Timestamp proto")
+		return f.Min, f.Max
+	}
+
+	waited := pf.now.Sub(ct).Seconds()
+	if waited <= 0 {
+		return f.Min, f.Max
+	}
+
+	r := f.Relaxation
+	minDelta := r.MinPerSecond * waited
+	maxDelta := r.MaxPerSecond * waited
+	if r.Limit > 0 {
+		minDelta = math.Min(minDelta, r.Limit)
+		maxDelta = math.Min(maxDelta, r.Limit)
 	}
 
-	return true
+	return f.Min - minDelta, f.Max + maxDelta
 }
@@ -15,7 +15,9 @@
 package filter
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/timestamp"
@@ -36,6 +38,7 @@ func TestMeetsCriteria(t *testing.T) {
 		if !pf.In(entity) {
 			t.Error("entity should be included in the pool")
 		}
+		require.Nil(t, pf.Explain(entity))
 	}
 
 	for _, tc := range testcases.IncludedTestCases() {
@@ -61,6 +64,10 @@ func TestMeetsCriteria(t *testing.T) {
 		if pf.In(entity) {
 			t.Error("ticket should be excluded from the pool")
 		}
+		r := pf.Explain(entity)
+		require.NotNil(t, r)
+		require.NotEmpty(t, r.Filter)
+		require.NotEmpty(t, r.Reason)
 	}
 
 	for _, tc := range testcases.ExcludedTestCases() {
@@ -78,6 +85,40 @@ func TestMeetsCriteria(t *testing.T) {
 	}
 }
 
+func TestExplain(t *testing.T) {
+	pf, err := NewPoolFilter(&pb.Pool{
+		DoubleRangeFilters: []*pb.DoubleRangeFilter{
+			{DoubleArg: "level", Min: 0, Max: 10},
+			{DoubleArg: "skill", Min: 100, Max: 200},
+		},
+		StringEqualsFilters: []*pb.StringEqualsFilter{{StringArg: "mode", Value: "ctf"}},
+		TagPresentFilters:   []*pb.TagPresentFilter{{Tag: "beta"}},
+	})
+	require.NoError(t, err)
+
+	ticket := &pb.Ticket{SearchFields: &pb.SearchFields{
+		DoubleArgs: map[string]float64{"level": 5, "skill": 250},
+		StringArgs: map[string]string{"mode": "ctf"},
+	}}
+	r := pf.Explain(ticket)
+	require.Equal(t, "double_range_filters[1]", r.Filter)
+	require.Contains(t, r.Reason, `"skill" is 250`)
+
+	ticket.SearchFields.DoubleArgs["skill"] = 150
+	r = pf.Explain(ticket)
+	require.Equal(t, "tag_present_filters[0]", r.Filter)
+	require.Contains(t, r.Reason, `"beta"`)
+
+	delete(ticket.SearchFields.StringArgs, "mode")
+	r = pf.Explain(ticket)
+	require.Equal(t, "string_equals_filters[0]", r.Filter)
+	require.Contains(t, r.Reason, "missing")
+
+	ticket.SearchFields.StringArgs["mode"] = "ctf"
+	ticket.SearchFields.Tags = []string{"beta"}
+	require.Nil(t, pf.Explain(ticket))
+}
+
 func TestValidPoolFilter(t *testing.T) {
 	for _, tc := range []struct {
 		name string
@@ -101,6 +142,26 @@ func TestValidPoolFilter(t *testing.T) {
 			codes.InvalidArgument,
 			".invalid created_after value",
 		},
+		{
+			"negative relaxation rate",
+			&pb.Pool{
+				DoubleRangeFilters: []*pb.DoubleRangeFilter{
+					{DoubleArg: "foo", Relaxation: &pb.DoubleRangeFilter_Relaxation{MinPerSecond: -1}},
+				},
+			},
+			codes.InvalidArgument,
+			".invalid relaxation value for double_arg foo",
+		},
+		{
+			"NaN relaxation limit",
+			&pb.Pool{
+				DoubleRangeFilters: []*pb.DoubleRangeFilter{
+					{DoubleArg: "foo", Relaxation: &pb.DoubleRangeFilter_Relaxation{Limit: math.NaN()}},
+				},
+			},
+			codes.InvalidArgument,
+			".invalid relaxation value for double_arg foo",
+		},
 	} {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
@@ -115,3 +176,62 @@ func TestValidPoolFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestRelaxation(t *testing.T) {
+	now := time.Now()
+	pool := &pb.Pool{
+		DoubleRangeFilters: []*pb.DoubleRangeFilter{
+			{
+				DoubleArg: "foo",
+				Min:       5,
+				Max:       10,
+				Relaxation: &pb.DoubleRangeFilter_Relaxation{
+					MinPerSecond: 1,
+					MaxPerSecond: 2,
+					Limit:        3,
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name   string
+		value  float64
+		waited time.Duration
+		in     bool
+	}{
+		{"in range without waiting", 5, 0, true},
+		{"below range without waiting", 4, 0, false},
+		{"above range without waiting", 11, 0, false},
+		{"min relaxed", 4, time.Second, true},
+		{"max relaxed", 12, time.Second, true},
+		{"min not relaxed enough", 3, time.Second, false},
+		{"max not relaxed enough", 12.5, time.Second, false},
+		{"min capped by limit", 1.9, time.Minute, false},
+		{"min at limit", 2, time.Minute, true},
+		{"max capped by limit", 13.1, time.Minute, false},
+		{"max at limit", 13, time.Minute, true},
+		{"created in the future", 4, -time.Minute, false},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pf, err := NewPoolFilterAt(pool, now)
+			require.NoError(t, err)
+
+			createTime, err := ptypes.TimestampProto(now.Add(-tc.waited))
+			require.NoError(t, err)
+
+			ticket := &pb.Ticket{
+				SearchFields: &pb.SearchFields{DoubleArgs: map[string]float64{"foo": tc.value}},
+				CreateTime:   createTime,
+			}
+			require.Equal(t, tc.in, pf.In(ticket))
+
+			backfill := &pb.Backfill{
+				SearchFields: &pb.SearchFields{DoubleArgs: map[string]float64{"foo": tc.value}},
+				CreateTime:   createTime,
+			}
+			require.Equal(t, tc.in, pf.In(backfill))
+		})
+	}
+}
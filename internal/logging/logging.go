@@ -16,6 +16,7 @@
 package logging
 
 import (
+	"fmt"
 	"strings"
 
 	stackdriver "github.com/TV4/logrus-stackdriver-formatter"
@@ -26,11 +27,47 @@ import (
 // ConfigureLogging sets up open match logrus instance using the logging section of the matchmaker_config.json
 //  - log line format (text[default] or json)
 //  - min log level to include (debug, info [default], warn, error, fatal, panic)
+// The logging is updated when the configuration is reloaded.
 func ConfigureLogging(cfg config.View) {
-	logrus.SetFormatter(newFormatter(cfg.GetString("logging.format")))
-	level := toLevel(cfg.GetString("logging.level"))
-	logrus.SetLevel(level)
-	if isDebugLevel(level) {
+	_, err := config.Subscribe(cfg, parseLogging, applyLogging)
+	if err != nil {
+		applyLogging(loggingConfig{
+			formatter: newFormatter(cfg.GetString("logging.format")),
+			level:     toLevel(cfg.GetString("logging.level")),
+		})
+		logrus.WithError(err).Warning("invalid logging configuration, changes of the logging configuration are ignored")
+	}
+}
+
+type loggingConfig struct {
+	formatter logrus.Formatter
+	level     logrus.Level
+}
+
+func parseLogging(cfg config.View) (interface{}, error) {
+	format := cfg.GetString("logging.format")
+	switch strings.ToLower(format) {
+	case "", "text", "stackdriver", "json":
+	default:
+		return nil, fmt.Errorf("unknown logging.format %q", format)
+	}
+
+	level := cfg.GetString("logging.level")
+	if _, err := logrus.ParseLevel(level); level != "" && err != nil {
+		return nil, fmt.Errorf("unknown logging.level %q", level)
+	}
+
+	return loggingConfig{
+		formatter: newFormatter(format),
+		level:     toLevel(level),
+	}, nil
+}
+
+func applyLogging(v interface{}) {
+	c := v.(loggingConfig)
+	logrus.SetFormatter(c.formatter)
+	logrus.SetLevel(c.level)
+	if isDebugLevel(c.level) {
 		logrus.Warn("Trace logging level configured. Not recommended for production!")
 	}
 }
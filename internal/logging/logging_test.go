@@ -21,6 +21,7 @@ import (
 
 	stackdriver "github.com/TV4/logrus-stackdriver-formatter"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
@@ -93,3 +94,22 @@ func TestToLevel(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLogging(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("logging.format", "json")
+	cfg.Set("logging.level", "debug")
+	v, err := parseLogging(cfg)
+	require.NoError(t, err)
+	require.Equal(t, logrus.DebugLevel, v.(loggingConfig).level)
+	require.IsType(t, &logrus.JSONFormatter{}, v.(loggingConfig).formatter)
+
+	cfg.Set("logging.level", "verbose")
+	_, err = parseLogging(cfg)
+	require.Error(t, err)
+
+	cfg.Set("logging.level", "info")
+	cfg.Set("logging.format", "xml")
+	_, err = parseLogging(cfg)
+	require.Error(t, err)
+}
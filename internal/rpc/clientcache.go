@@ -17,63 +17,153 @@ package rpc
 import (
 	"net/http"
 	"sync"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"open-match.dev/open-match/internal/config"
 )
 
-// ClientCache holds GRPC and HTTP clients based on an address.
+// ClientCache holds GRPC and HTTP clients based on an address. The clients
+// are reference counted: an evicted client is closed once the calls using it
+// are done.
 type ClientCache struct {
-	cfg   config.View
-	cache *sync.Map
-}
+	cfg config.View
+	now func() time.Time
 
-type cachedGRPCClient struct {
-	client *grpc.ClientConn
+	mu      sync.Mutex
+	clients map[string]*cachedClient
 }
 
-type cachedHTTPClient struct {
-	client  *http.Client
-	baseURL string
+type cachedClient struct {
+	grpcClient *grpc.ClientConn
+	httpClient *http.Client
+	baseURL    string
+
+	// inUse is the number of Gets not released yet.
+	inUse    int
+	lastUsed time.Time
+	evicted  bool
 }
 
-// GetGRPC gets a GRPC client with the address.
-func (cc *ClientCache) GetGRPC(address string) (*grpc.ClientConn, error) {
-	val, exists := cc.cache.Load(address)
-	c, ok := val.(cachedGRPCClient)
-	if !ok || !exists {
+// GetGRPC gets a GRPC client with the address. release must be called once
+// the client is no longer used.
+func (cc *ClientCache) GetGRPC(address string) (conn *grpc.ClientConn, release func(), err error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	c, ok := cc.clients[address]
+	if ok && (c.grpcClient == nil || c.grpcClient.GetState() == connectivity.Shutdown) {
+		cc.evict(address, c)
+		ok = false
+	}
+	if !ok {
 		conn, err := GRPCClientFromEndpoint(cc.cfg, address)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		c = cachedGRPCClient{
-			client: conn,
-		}
-		cc.cache.Store(address, c)
+		c = &cachedClient{grpcClient: conn}
+		cc.clients[address] = c
 	}
 
-	return c.client, nil
+	return c.grpcClient, cc.acquire(c), nil
 }
 
-// GetHTTP gets a HTTP client with the address.
-func (cc *ClientCache) GetHTTP(address string) (*http.Client, string, error) {
-	val, exists := cc.cache.Load(address)
-	c, ok := val.(cachedHTTPClient)
-	if !ok || !exists {
+// GetHTTP gets a HTTP client with the address. release must be called once
+// the client is no longer used.
+func (cc *ClientCache) GetHTTP(address string) (client *http.Client, baseURL string, release func(), err error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	c, ok := cc.clients[address]
+	if ok && c.httpClient == nil {
+		cc.evict(address, c)
+		ok = false
+	}
+	if !ok {
 		client, baseURL, err := HTTPClientFromEndpoint(cc.cfg, address)
 		if err != nil {
-			return nil, "", err
+			return nil, "", nil, err
 		}
-		c = cachedHTTPClient{client, baseURL}
-		cc.cache.Store(address, c)
+		c = &cachedClient{httpClient: client, baseURL: baseURL}
+		cc.clients[address] = c
+	}
+
+	return c.httpClient, c.baseURL, cc.acquire(c), nil
+}
+
+// acquire marks the client as used until the returned func is called. cc.mu
+// must be held.
+func (cc *ClientCache) acquire(c *cachedClient) func() {
+	c.inUse++
+	c.lastUsed = cc.now()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			cc.mu.Lock()
+			defer cc.mu.Unlock()
+			c.inUse--
+			c.lastUsed = cc.now()
+			if c.evicted && c.inUse == 0 {
+				c.close()
+			}
+		})
+	}
+}
+
+// Evict removes the clients of the address from the cache, so the next Get
+// creates new connections. They are closed once the calls using them are
+// done.
+func (cc *ClientCache) Evict(address string) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if c, ok := cc.clients[address]; ok {
+		cc.evict(address, c)
+	}
+}
+
+// EvictIdle evicts the clients which are not in use and were not used for the
+// given duration.
+func (cc *ClientCache) EvictIdle(maxIdle time.Duration) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	deadline := cc.now().Add(-maxIdle)
+	for address, c := range cc.clients {
+		if c.inUse == 0 && c.lastUsed.Before(deadline) {
+			cc.evict(address, c)
+		}
+	}
+}
+
+// evict removes the client from the cache, and closes it unless it is in use.
+// cc.mu must be held.
+func (cc *ClientCache) evict(address string, c *cachedClient) {
+	delete(cc.clients, address)
+	c.evicted = true
+	if c.inUse == 0 {
+		c.close()
+	}
+}
+
+func (c *cachedClient) close() {
+	if c.grpcClient != nil {
+		if err := c.grpcClient.Close(); err != nil {
+			clientLogger.WithError(err).Debug("failed to close an evicted grpc client")
+		}
+	}
+	if c.httpClient != nil {
+		c.httpClient.CloseIdleConnections()
 	}
-	return c.client, c.baseURL, nil
 }
 
 // NewClientCache creates a cache with all the clients.
 func NewClientCache(cfg config.View) *ClientCache {
 	return &ClientCache{
-		cfg:   cfg,
-		cache: &sync.Map{},
+		cfg:     cfg,
+		now:     time.Now,
+		clients: make(map[string]*cachedClient),
 	}
 }
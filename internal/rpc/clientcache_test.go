@@ -16,9 +16,11 @@ package rpc
 
 import (
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/connectivity"
 )
 
 const (
@@ -30,11 +32,13 @@ func TestGetGRPC(t *testing.T) {
 	require := require.New(t)
 
 	cc := NewClientCache(viper.New())
-	client, err := cc.GetGRPC(fakeGRPCAddress)
+	client, release, err := cc.GetGRPC(fakeGRPCAddress)
 	require.Nil(err)
+	release()
 
-	cachedClient, err := cc.GetGRPC(fakeGRPCAddress)
+	cachedClient, release, err := cc.GetGRPC(fakeGRPCAddress)
 	require.Nil(err)
+	release()
 
 	// Test caching by comparing pointer value
 	require.EqualValues(client, cachedClient)
@@ -44,14 +48,101 @@ func TestGetHTTP(t *testing.T) {
 	require := require.New(t)
 
 	cc := NewClientCache(viper.New())
-	client, address, err := cc.GetHTTP(fakeHTTPAddress)
+	client, address, release, err := cc.GetHTTP(fakeHTTPAddress)
 	require.Nil(err)
 	require.Equal(fakeHTTPAddress, address)
+	release()
 
-	cachedClient, address, err := cc.GetHTTP(fakeHTTPAddress)
+	cachedClient, address, release, err := cc.GetHTTP(fakeHTTPAddress)
 	require.Nil(err)
 	require.Equal(fakeHTTPAddress, address)
+	release()
 
 	// Test caching by comparing pointer value
 	require.EqualValues(client, cachedClient)
 }
+
+func TestEvict(t *testing.T) {
+	require := require.New(t)
+
+	cc := NewClientCache(viper.New())
+	client, release, err := cc.GetGRPC(fakeGRPCAddress)
+	require.Nil(err)
+	release()
+
+	cc.Evict(fakeGRPCAddress)
+	require.Equal(connectivity.Shutdown, client.GetState())
+
+	newClient, release, err := cc.GetGRPC(fakeGRPCAddress)
+	require.Nil(err)
+	release()
+	require.True(client != newClient)
+}
+
+func TestEvictInUse(t *testing.T) {
+	require := require.New(t)
+
+	cc := NewClientCache(viper.New())
+	client, release, err := cc.GetGRPC(fakeGRPCAddress)
+	require.Nil(err)
+	_, releaseAgain, err := cc.GetGRPC(fakeGRPCAddress)
+	require.Nil(err)
+
+	// The evicted client is closed once the calls using it are done.
+	cc.Evict(fakeGRPCAddress)
+	require.NotEqual(connectivity.Shutdown, client.GetState())
+	newClient, releaseNew, err := cc.GetGRPC(fakeGRPCAddress)
+	require.Nil(err)
+	require.True(client != newClient)
+
+	// Releasing twice is a no-op.
+	release()
+	release()
+	require.NotEqual(connectivity.Shutdown, client.GetState())
+	releaseAgain()
+	require.Equal(connectivity.Shutdown, client.GetState())
+
+	releaseNew()
+	require.NotEqual(connectivity.Shutdown, newClient.GetState())
+}
+
+func TestEvictIdle(t *testing.T) {
+	require := require.New(t)
+
+	now := time.Now()
+	cc := NewClientCache(viper.New())
+	cc.now = func() time.Time { return now }
+
+	idle, release, err := cc.GetGRPC(fakeGRPCAddress)
+	require.Nil(err)
+	release()
+	idleHTTP, _, release, err := cc.GetHTTP(fakeHTTPAddress)
+	require.Nil(err)
+	release()
+	inUse, releaseInUse, err := cc.GetGRPC("om-test:23456")
+	require.Nil(err)
+
+	now = now.Add(time.Minute)
+	used, release, err := cc.GetGRPC("om-test:12345")
+	require.Nil(err)
+	release()
+
+	cc.EvictIdle(30 * time.Second)
+	require.Equal(connectivity.Shutdown, idle.GetState())
+	require.NotEqual(connectivity.Shutdown, used.GetState())
+	require.NotEqual(connectivity.Shutdown, inUse.GetState())
+	releaseInUse()
+
+	cachedHTTP, _, release, err := cc.GetHTTP(fakeHTTPAddress)
+	require.Nil(err)
+	release()
+	require.True(idleHTTP != cachedHTTP)
+	cachedUsed, release, err := cc.GetGRPC("om-test:12345")
+	require.Nil(err)
+	release()
+	require.True(used == cachedUsed)
+	cachedInUse, release, err := cc.GetGRPC("om-test:23456")
+	require.Nil(err)
+	release()
+	require.True(inUse == cachedInUse)
+}
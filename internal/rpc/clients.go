@@ -27,14 +27,12 @@ import (
 	"time"
 
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
-	"go.opencensus.io/plugin/ocgrpc"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_tracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
-	"go.opencensus.io/plugin/ochttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
@@ -86,7 +84,7 @@ func GRPCClientFromConfig(cfg config.View, prefix string) (*grpc.ClientConn, err
 		Address:                 toAddress(cfg.GetString(prefix+".hostname"), cfg.GetInt(prefix+".grpcport")),
 		EnableRPCLogging:        cfg.GetBool(ConfigNameEnableRPCLogging),
 		EnableRPCPayloadLogging: logging.IsDebugEnabled(cfg),
-		EnableMetrics:           cfg.GetBool(telemetry.ConfigNameEnableMetrics),
+		EnableMetrics:           telemetry.IsInstrumented(cfg),
 	}
 
 	// If TLS support is enabled in the config, fill in the trusted certificates for decrpting server certificate.
@@ -110,7 +108,7 @@ func GRPCClientFromConfig(cfg config.View, prefix string) (*grpc.ClientConn, err
 // GRPCClientFromEndpoint creates a gRPC client connection from endpoint.
 func GRPCClientFromEndpoint(cfg config.View, address string) (*grpc.ClientConn, error) {
 	// TODO: investigate if it is possible to keep a cache of the certpool and transport credentials
-	grpcOptions := newGRPCDialOptions(cfg.GetBool(telemetry.ConfigNameEnableMetrics), cfg.GetBool(ConfigNameEnableRPCLogging), logging.IsDebugEnabled(cfg))
+	grpcOptions := newGRPCDialOptions(telemetry.IsInstrumented(cfg), cfg.GetBool(ConfigNameEnableRPCLogging), logging.IsDebugEnabled(cfg))
 
 	if cfg.GetString(configNameClientTrustedCertificatePath) != "" {
 		_, err := os.Stat(cfg.GetString(configNameClientTrustedCertificatePath))
@@ -164,7 +162,7 @@ func HTTPClientFromConfig(cfg config.View, prefix string) (*http.Client, string,
 		Address:                 toAddress(cfg.GetString(prefix+".hostname"), cfg.GetInt(prefix+".httpport")),
 		EnableRPCLogging:        cfg.GetBool(ConfigNameEnableRPCLogging),
 		EnableRPCPayloadLogging: logging.IsDebugEnabled(cfg),
-		EnableMetrics:           cfg.GetBool(telemetry.ConfigNameEnableMetrics),
+		EnableMetrics:           telemetry.IsInstrumented(cfg),
 	}
 
 	// If TLS support is enabled in the config, fill in the trusted certificates for decrpting server certificate.
@@ -209,7 +207,7 @@ func HTTPClientFromEndpoint(cfg config.View, address string) (*http.Client, stri
 		Address:                 address,
 		EnableRPCLogging:        cfg.GetBool(ConfigNameEnableRPCLogging),
 		EnableRPCPayloadLogging: logging.IsDebugEnabled(cfg),
-		EnableMetrics:           cfg.GetBool(telemetry.ConfigNameEnableMetrics),
+		EnableMetrics:           telemetry.IsInstrumented(cfg),
 	}
 	if cfg.GetString(configNameClientTrustedCertificatePath) != "" {
 		_, err := os.Stat(cfg.GetString(configNameClientTrustedCertificatePath))
@@ -273,11 +271,7 @@ func HTTPClientFromParams(params *ClientParams) (*http.Client, string, error) {
 	}
 
 	if params.EnableMetrics {
-		attachTransport(httpClient, func(transport http.RoundTripper) http.RoundTripper {
-			return &ochttp.Transport{
-				Base: transport,
-			}
-		})
+		attachTransport(httpClient, telemetry.HTTPTransport)
 	}
 
 	return httpClient, baseURL, nil
@@ -318,7 +312,7 @@ func newGRPCDialOptions(enableMetrics bool, enableRPCLogging bool, enableRPCPayl
 		}),
 	}
 	if enableMetrics {
-		opts = append(opts, grpc.WithStatsHandler(new(ocgrpc.ClientHandler)))
+		opts = append(opts, grpc.WithStatsHandler(telemetry.GRPCClientHandler()))
 	}
 	return opts
 }
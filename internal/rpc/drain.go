@@ -0,0 +1,150 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+)
+
+const (
+	configNameDrainTimeout  = "shutdown.drainTimeout"
+	configNameNotReadyDelay = "shutdown.notReadyDelay"
+
+	defaultDrainTimeout = 20 * time.Second
+)
+
+type drainingKey struct{}
+
+// drainer lets the streams of a server end before it stops. When the server
+// drains:
+//  1. the readiness probe fails, so that no new traffic is routed to it,
+//  2. after notReadyDelay, new streams are refused and the streams watching
+//     for events are told to reconnect, see Draining,
+//  3. the other streams, such as the FetchMatches calls of the running
+//     synchronizer cycle, are waited for until the drain timeout.
+type drainer struct {
+	timeout       time.Duration
+	notReadyDelay time.Duration
+
+	mu       sync.Mutex
+	notReady bool
+	draining chan struct{}
+	streams  sync.WaitGroup
+}
+
+func newDrainer() *drainer {
+	return &drainer{
+		timeout:  defaultDrainTimeout,
+		draining: make(chan struct{}),
+	}
+}
+
+func (d *drainer) configure(cfg config.View) {
+	if cfg.IsSet(configNameDrainTimeout) {
+		d.timeout = cfg.GetDuration(configNameDrainTimeout)
+	}
+	d.notReadyDelay = cfg.GetDuration(configNameNotReadyDelay)
+}
+
+// healthCheck fails once the server started draining.
+func (d *drainer) healthCheck(context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.notReady {
+		return status.Error(codes.Unavailable, "server is shutting down")
+	}
+	return nil
+}
+
+func (d *drainer) streamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	d.mu.Lock()
+	select {
+	case <-d.draining:
+		d.mu.Unlock()
+		return DrainingError()
+	default:
+	}
+	d.streams.Add(1)
+	d.mu.Unlock()
+	defer d.streams.Done()
+
+	wrapped := grpc_middleware.WrapServerStream(ss)
+	wrapped.WrappedContext = context.WithValue(ss.Context(), drainingKey{}, d.draining)
+	return handler(srv, wrapped)
+}
+
+// drain returns once all the streams ended, or the drain timeout expired.
+func (d *drainer) drain() error {
+	ctx, cancel := context.WithTimeout(context.Background(), d.timeout)
+	defer cancel()
+
+	d.mu.Lock()
+	if d.notReady {
+		d.mu.Unlock()
+		return nil
+	}
+	d.notReady = true
+	d.mu.Unlock()
+
+	select {
+	case <-time.After(d.notReadyDelay):
+	case <-ctx.Done():
+	}
+
+	d.mu.Lock()
+	close(d.draining)
+	d.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		d.streams.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return status.Errorf(codes.DeadlineExceeded, "streams still running after the drain timeout of %v", d.timeout)
+	}
+}
+
+// Draining returns a channel closed when the server handling the stream of
+// ctx starts draining. Streams which would otherwise never end, such as the
+// ones watching for events, must then return DrainingError.
+func Draining(ctx context.Context) <-chan struct{} {
+	draining, _ := ctx.Value(drainingKey{}).(chan struct{})
+	return draining
+}
+
+// DrainingError tells the clients that the server is shutting down, and that
+// they should reconnect right away to another instance.
+func DrainingError() error {
+	st := status.New(codes.Unavailable, "server is shutting down, reconnect to another instance")
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(0)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,120 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestDrain(t *testing.T) {
+	require := require.New(t)
+	d := newDrainer()
+	d.timeout = time.Second
+	info := &grpc.StreamServerInfo{FullMethod: "/openmatch.FrontendService/WatchAssignments"}
+	stream := &testServerStream{ctx: context.Background()}
+
+	// A watching stream, ending when the server drains.
+	watching := make(chan struct{})
+	watchErr := make(chan error)
+	go func() {
+		watchErr <- d.streamServerInterceptor(nil, stream, info, func(_ interface{}, ss grpc.ServerStream) error {
+			close(watching)
+			<-Draining(ss.Context())
+			return DrainingError()
+		})
+	}()
+	<-watching
+
+	// A stream completing its work.
+	finish := make(chan struct{})
+	running := make(chan struct{})
+	go func() {
+		_ = d.streamServerInterceptor(nil, stream, info, func(interface{}, grpc.ServerStream) error {
+			close(running)
+			<-finish
+			return nil
+		})
+	}()
+	<-running
+
+	require.NoError(d.healthCheck(context.Background()))
+
+	drained := make(chan error)
+	go func() {
+		drained <- d.drain()
+	}()
+
+	err := <-watchErr
+	st := status.Convert(err)
+	require.Equal(codes.Unavailable, st.Code())
+	require.IsType(&errdetails.RetryInfo{}, st.Details()[0])
+	require.Error(d.healthCheck(context.Background()))
+
+	// New streams are refused.
+	err = d.streamServerInterceptor(nil, stream, info, func(interface{}, grpc.ServerStream) error {
+		require.Fail("stream started while draining")
+		return nil
+	})
+	require.Equal(codes.Unavailable, status.Code(err))
+
+	select {
+	case <-drained:
+		require.Fail("drained before the running stream ended")
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(finish)
+	require.NoError(<-drained)
+}
+
+func TestDrainTimeout(t *testing.T) {
+	d := newDrainer()
+	d.timeout = 10 * time.Millisecond
+	stream := &testServerStream{ctx: context.Background()}
+
+	running := make(chan struct{})
+	finish := make(chan struct{})
+	defer close(finish)
+	go func() {
+		_ = d.streamServerInterceptor(nil, stream, &grpc.StreamServerInfo{}, func(interface{}, grpc.ServerStream) error {
+			close(running)
+			<-finish
+			return nil
+		})
+	}()
+	<-running
+
+	require.Equal(t, codes.DeadlineExceeded, status.Code(d.drain()))
+}
+
+func TestDrainingWithoutDrainer(t *testing.T) {
+	require.Nil(t, Draining(context.Background()))
+}
@@ -81,7 +81,7 @@ func (s *insecureServer) start(params *ServerParams) error {
 	}
 
 	s.httpMux.Handle(telemetry.HealthCheckEndpoint, telemetry.NewHealthCheck(params.handlersForHealthCheck))
-	s.httpMux.Handle("/", s.proxyMux)
+	s.httpMux.Handle("/", params.proxyHandler(s.proxyMux))
 	s.httpServer = &http.Server{
 		Addr:    s.httpListener.Addr().String(),
 		Handler: instrumentHTTPHandler(s.httpMux, params),
@@ -98,10 +98,14 @@ func (s *insecureServer) start(params *ServerParams) error {
 	return nil
 }
 
-func (s *insecureServer) stop() error {
+func (s *insecureServer) stop(graceful bool) error {
 	// the servers also close their respective listeners.
 	err := s.httpServer.Shutdown(context.Background())
-	s.grpcServer.GracefulStop()
+	if graceful {
+		s.grpcServer.GracefulStop()
+	} else {
+		s.grpcServer.Stop()
+	}
 	return err
 }
 
@@ -38,7 +38,7 @@ func TestInsecureStartStop(t *testing.T) {
 		pb.RegisterFrontendServiceServer(s, ff)
 	}, pb.RegisterFrontendServiceHandlerFromEndpoint)
 	s := newInsecureServer(grpcL, httpL)
-	defer s.stop()
+	defer s.stop(true)
 	err := s.start(params)
 	require.Nil(err)
 
@@ -0,0 +1,468 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/auth"
+	"open-match.dev/open-match/internal/config"
+)
+
+const (
+	configNameRateLimitEnable      = "rateLimit.enable"
+	configNameRateLimitKey         = "rateLimit.key"
+	configNameRateLimitMetadataKey = "rateLimit.metadataKey"
+	configNameRateLimitMethods     = "rateLimit.methods"
+
+	// Callers are identified by the address of the client.
+	rateLimitKeyPeer = "peer"
+	// Callers are identified by their authenticated subject.
+	rateLimitKeySubject = "subject"
+	// Callers are identified by the value of the rateLimit.metadataKey metadata.
+	rateLimitKeyMetadata = "metadata"
+	// All callers share the limits.
+	rateLimitKeyGlobal = "global"
+
+	// xForwardedFor is set by the HTTP gateway to the address of the HTTP client.
+	xForwardedFor = "x-forwarded-for"
+	// gatewayProxyHeader is set by the HTTP gateway of this server to the
+	// gatewayToken of the rate limiter, and forwarded by grpc-gateway to the
+	// gRPC server as the gatewayProxyMetadata.
+	gatewayProxyHeader   = "Grpc-Metadata-Open-Match-Gateway-Proxy"
+	gatewayProxyMetadata = "open-match-gateway-proxy"
+
+	// Buckets which are full again are forgotten every bucketSweepInterval.
+	bucketSweepInterval = time.Minute
+	// concurrencyRetryDelay is the retry delay suggested to callers rejected
+	// because of too many concurrent calls.
+	concurrencyRetryDelay = time.Second
+)
+
+var (
+	keyMethod = tag.MustNewKey("method")
+	keyReason = tag.MustNewKey("reason")
+
+	throttledCalls     = stats.Int64("open-match.dev/rpc/throttled_calls", "Number of calls rejected by the rate limits", stats.UnitDimensionless)
+	throttledCallsView = &view.View{
+		Measure:     throttledCalls,
+		Name:        "open-match.dev/rpc/throttled_calls",
+		Description: "Number of calls rejected by the rate limits",
+		Aggregation: view.Sum(),
+		TagKeys:     []tag.Key{keyMethod, keyReason},
+	}
+)
+
+// rateLimiter enforces per caller token bucket limits and maximum concurrent
+// calls on the methods configured under rateLimit.methods, keyed by service
+// and method name:
+//
+//	rateLimit:
+//	  methods:
+//	    FrontendService:
+//	      CreateTicket:
+//	        rate: 10     # calls per second per caller
+//	        burst: 20
+//	      WatchAssignments:
+//	        maxConcurrent: 10000  # for all callers of this server
+type rateLimiter struct {
+	cfg config.View
+	now func() time.Time
+	// gatewayToken marks the calls proxied by the HTTP gateway of this
+	// server, the only ones whose X-Forwarded-For is trusted. It never leaves
+	// the process.
+	gatewayToken string
+
+	mu      sync.RWMutex
+	methods map[string]*methodLimiter
+}
+
+type methodLimiter struct {
+	ctx context.Context
+
+	mu sync.Mutex
+	methodLimits
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+	inFlight  int64
+}
+
+// methodLimits are the configured limits of a method, replaced when the
+// configuration is reloaded.
+type methodLimits struct {
+	key           string
+	rate          float64
+	burst         float64
+	maxConcurrent int64
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns nil if rate limiting is disabled. The limits are
+// read again from the configuration when it is reloaded.
+func newRateLimiter(cfg config.View) (*rateLimiter, error) {
+	if !cfg.GetBool(configNameRateLimitEnable) {
+		return nil, nil
+	}
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return nil, errors.Wrap(err, "failed to generate the gateway token")
+	}
+	rl := &rateLimiter{
+		cfg:          cfg,
+		now:          time.Now,
+		gatewayToken: base64.RawURLEncoding.EncodeToString(token),
+		methods:      make(map[string]*methodLimiter),
+	}
+
+	_, err := config.Subscribe(cfg, validateRateLimits, func(interface{}) { rl.reload() })
+	if err != nil {
+		return nil, err
+	}
+	return rl, nil
+}
+
+// reload reads the limits of the methods called so far from the reloaded
+// configuration. The limiters of the methods which are still limited are
+// kept, so that the calls in progress and the buckets of the callers are
+// still counted.
+func (rl *rateLimiter) reload() {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	for fullMethod, l := range rl.methods {
+		limits, ok := rl.methodLimits(fullMethod)
+		switch {
+		case !ok:
+			rl.methods[fullMethod] = nil
+		case l == nil:
+			rl.methods[fullMethod] = rl.newMethodLimiter(fullMethod, limits)
+		default:
+			l.mu.Lock()
+			l.methodLimits = limits
+			l.mu.Unlock()
+		}
+	}
+}
+
+// validateRateLimits rejects the configurations with unknown caller keys or
+// negative limits.
+func validateRateLimits(cfg config.View) (interface{}, error) {
+	validKey := func(name, key string) error {
+		switch key {
+		case "", rateLimitKeyPeer, rateLimitKeySubject, rateLimitKeyMetadata, rateLimitKeyGlobal:
+			return nil
+		}
+		return fmt.Errorf("invalid %s %q, expected one of peer, subject, metadata or global", name, key)
+	}
+	if err := validKey(configNameRateLimitKey, cfg.GetString(configNameRateLimitKey)); err != nil {
+		return nil, err
+	}
+
+	for _, service := range configKeys(cfg.Get(configNameRateLimitMethods)) {
+		for _, method := range configKeys(cfg.Get(configNameRateLimitMethods + "." + service)) {
+			prefix := configNameRateLimitMethods + "." + service + "." + method
+			for _, name := range []string{".rate", ".burst", ".maxConcurrent"} {
+				if cfg.GetFloat64(prefix+name) < 0 {
+					return nil, fmt.Errorf("%s%s must not be negative", prefix, name)
+				}
+			}
+			if cfg.IsSet(prefix + ".key") {
+				if err := validKey(prefix+".key", cfg.GetString(prefix+".key")); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// configKeys returns the keys of a map read from the configuration.
+func configKeys(v interface{}) []string {
+	var keys []string
+	switch m := v.(type) {
+	case map[string]interface{}:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[interface{}]interface{}:
+		for k := range m {
+			keys = append(keys, fmt.Sprint(k))
+		}
+	}
+	return keys
+}
+
+// limiter returns the limits of the method, nil if it is not limited. The
+// limits are read from the configuration on the first call of the method.
+func (rl *rateLimiter) limiter(fullMethod string) *methodLimiter {
+	rl.mu.RLock()
+	l, ok := rl.methods[fullMethod]
+	rl.mu.RUnlock()
+	if ok {
+		return l
+	}
+
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if l, ok = rl.methods[fullMethod]; ok {
+		return l
+	}
+	if limits, ok := rl.methodLimits(fullMethod); ok {
+		l = rl.newMethodLimiter(fullMethod, limits)
+	}
+	rl.methods[fullMethod] = l
+	return l
+}
+
+// methodLimits reads the limits of the method, false if it is not limited.
+func (rl *rateLimiter) methodLimits(fullMethod string) (methodLimits, bool) {
+	prefix := configNameRateLimitMethods + "." + methodConfigName(fullMethod)
+	rate := rl.cfg.GetFloat64(prefix + ".rate")
+	maxConcurrent := rl.cfg.GetInt64(prefix + ".maxConcurrent")
+	if rate <= 0 && maxConcurrent <= 0 {
+		return methodLimits{}, false
+	}
+
+	burst := rl.cfg.GetFloat64(prefix + ".burst")
+	if burst < 1 {
+		burst = math.Max(1, math.Ceil(rate))
+	}
+	key := rl.cfg.GetString(configNameRateLimitKey)
+	if rl.cfg.IsSet(prefix + ".key") {
+		key = rl.cfg.GetString(prefix + ".key")
+	}
+	return methodLimits{key: key, rate: rate, burst: burst, maxConcurrent: maxConcurrent}, true
+}
+
+func (rl *rateLimiter) newMethodLimiter(fullMethod string, limits methodLimits) *methodLimiter {
+	ctx, err := tag.New(context.Background(), tag.Insert(keyMethod, fullMethod))
+	if err != nil {
+		serverLogger.WithError(err).Errorf("failed to tag the rate limit metrics of %s", fullMethod)
+		ctx = context.Background()
+	}
+
+	return &methodLimiter{
+		ctx:          ctx,
+		methodLimits: limits,
+		buckets:      make(map[string]*tokenBucket),
+		lastSweep:    rl.now(),
+	}
+}
+
+// methodConfigName turns "/openmatch.FrontendService/CreateTicket" into
+// "FrontendService.CreateTicket", as the package would be split into
+// several configuration keys.
+func methodConfigName(fullMethod string) string {
+	name := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		service := name[:i]
+		if j := strings.LastIndex(service, "."); j >= 0 {
+			service = service[j+1:]
+		}
+		return service + "." + name[i+1:]
+	}
+	return name
+}
+
+// admit returns a function to call once the call is done, or a
+// RESOURCE_EXHAUSTED error with the delay after which the caller may retry.
+func (rl *rateLimiter) admit(ctx context.Context, fullMethod string) (func(), error) {
+	l := rl.limiter(fullMethod)
+	if l == nil {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	limits := l.methodLimits
+	l.mu.Unlock()
+
+	if limits.rate > 0 {
+		caller := limits.callerKey(ctx, rl.cfg, rl.gatewayToken)
+		if ok, retryAfter := l.take(caller, rl.now()); !ok {
+			throttle(l.ctx, "rate")
+			return nil, resourceExhausted(retryAfter, "rate limit of %s exceeded for %s, retry in %v", fullMethod, caller, retryAfter)
+		}
+	}
+
+	if limits.maxConcurrent > 0 {
+		l.mu.Lock()
+		if l.inFlight >= l.maxConcurrent {
+			l.mu.Unlock()
+			throttle(l.ctx, "concurrency")
+			return nil, resourceExhausted(concurrencyRetryDelay, "too many concurrent calls of %s, limit is %d", fullMethod, l.maxConcurrent)
+		}
+		l.inFlight++
+		l.mu.Unlock()
+
+		return func() {
+			l.mu.Lock()
+			l.inFlight--
+			l.mu.Unlock()
+		}, nil
+	}
+
+	return func() {}, nil
+}
+
+// take removes a token from the bucket of the caller. If the bucket is empty
+// it returns the time until the next token is available.
+func (l *methodLimiter) take(caller string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= bucketSweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[caller]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[caller] = b
+	}
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / l.rate * float64(time.Second))
+}
+
+// sweep forgets the buckets which are full again, as they are the same as new ones.
+func (l *methodLimiter) sweep(now time.Time) {
+	for caller, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, caller)
+		}
+	}
+	l.lastSweep = now
+}
+
+// callerKey identifies the caller the limits apply to. It falls back to the
+// address of the client if the configured identity is not available.
+func (l methodLimits) callerKey(ctx context.Context, cfg config.View, gatewayToken string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	switch l.key {
+	case rateLimitKeyGlobal:
+		return rateLimitKeyGlobal
+	case rateLimitKeySubject:
+		if id, ok := auth.FromContext(ctx); ok && id.Subject != "" {
+			return "subject:" + id.Subject
+		}
+	case rateLimitKeyMetadata:
+		if values := md.Get(cfg.GetString(configNameRateLimitMetadataKey)); len(values) > 0 && values[0] != "" {
+			return "metadata:" + values[0]
+		}
+	}
+	return "peer:" + peerAddress(ctx, md, gatewayToken)
+}
+
+// peerAddress returns the IP of the client. For calls proxied by the HTTP
+// gateway of this server, marked with the gatewayToken, it is the last address
+// of X-Forwarded-For, set by the gateway: the previous ones are set by the
+// caller and can't be trusted. The X-Forwarded-For of other calls is ignored,
+// even from the loopback, as any local process could set it.
+func peerAddress(ctx context.Context, md metadata.MD, gatewayToken string) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if proxied := md.Get(gatewayProxyMetadata); len(proxied) == 1 && gatewayToken != "" &&
+		subtle.ConstantTimeCompare([]byte(proxied[0]), []byte(gatewayToken)) == 1 {
+		if values := md.Get(xForwardedFor); len(values) > 0 {
+			forwarded := strings.Split(values[len(values)-1], ",")
+			return strings.TrimSpace(forwarded[len(forwarded)-1])
+		}
+	}
+	return host
+}
+
+// HTTPHandler marks the requests to the HTTP gateway as proxied by this
+// server, so that their X-Forwarded-For is trusted by the rate limits.
+func (rl *rateLimiter) HTTPHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Never trust a marker set by the caller.
+		req.Header.Del(gatewayProxyHeader)
+		req.Header.Set(gatewayProxyHeader, rl.gatewayToken)
+		handler.ServeHTTP(w, req)
+	})
+}
+
+func throttle(ctx context.Context, reason string) {
+	if err := stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(keyReason, reason)}, throttledCalls.M(1)); err != nil {
+		serverLogger.WithError(err).Debug("failed to record a throttled call")
+	}
+}
+
+func resourceExhausted(retryAfter time.Duration, format string, a ...interface{}) error {
+	st := status.Newf(codes.ResourceExhausted, format, a...)
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: ptypes.DurationProto(retryAfter),
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func (rl *rateLimiter) unaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	done, err := rl.admit(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	return handler(ctx, req)
+}
+
+func (rl *rateLimiter) streamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	done, err := rl.admit(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer done()
+	return handler(srv, stream)
+}
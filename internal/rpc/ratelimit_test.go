@@ -0,0 +1,247 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/auth"
+)
+
+const (
+	createTicketMethod     = "/openmatch.FrontendService/CreateTicket"
+	watchAssignmentsMethod = "/openmatch.FrontendService/WatchAssignments"
+)
+
+func newTestRateLimiter(t *testing.T, now *time.Time) *rateLimiter {
+	cfg := viper.New()
+	cfg.Set("rateLimit.enable", true)
+	cfg.Set("rateLimit.key", "subject")
+	cfg.Set("rateLimit.methods.FrontendService.CreateTicket.rate", 2)
+	cfg.Set("rateLimit.methods.FrontendService.CreateTicket.burst", 3)
+	cfg.Set("rateLimit.methods.FrontendService.WatchAssignments.maxConcurrent", 2)
+
+	rl, err := newRateLimiter(cfg)
+	require.NoError(t, err)
+	rl.now = func() time.Time { return *now }
+	return rl
+}
+
+func peerContext(addr string, md metadata.MD) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	tcpAddr, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(ctx, &peer.Peer{Addr: tcpAddr})
+}
+
+func TestRateLimit(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	rl := newTestRateLimiter(t, &now)
+	player := auth.NewContext(peerContext("10.0.0.1:1234", nil), &auth.Identity{Subject: "player"})
+	other := auth.NewContext(peerContext("10.0.0.1:1234", nil), &auth.Identity{Subject: "other"})
+
+	// The burst is available at once.
+	for i := 0; i < 3; i++ {
+		done, err := rl.admit(player, createTicketMethod)
+		require.NoError(t, err)
+		done()
+	}
+
+	_, err := rl.admit(player, createTicketMethod)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	retryDelay, err := ptypes.Duration(details[0].(*errdetails.RetryInfo).RetryDelay)
+	require.NoError(t, err)
+	require.Equal(t, 500*time.Millisecond, retryDelay)
+
+	// Callers have their own buckets.
+	_, err = rl.admit(other, createTicketMethod)
+	require.NoError(t, err)
+
+	// Tokens are refilled at the configured rate.
+	now = now.Add(500 * time.Millisecond)
+	_, err = rl.admit(player, createTicketMethod)
+	require.NoError(t, err)
+	_, err = rl.admit(player, createTicketMethod)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Methods without limits are not throttled.
+	for i := 0; i < 10; i++ {
+		_, err = rl.admit(player, "/openmatch.FrontendService/GetTicket")
+		require.NoError(t, err)
+	}
+
+	// Full buckets are forgotten.
+	now = now.Add(bucketSweepInterval)
+	_, err = rl.admit(player, createTicketMethod)
+	require.NoError(t, err)
+	require.Len(t, rl.limiter(createTicketMethod).buckets, 1)
+}
+
+func TestRateLimitConcurrency(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	rl := newTestRateLimiter(t, &now)
+	ctx := peerContext("10.0.0.1:1234", nil)
+
+	done1, err := rl.admit(ctx, watchAssignmentsMethod)
+	require.NoError(t, err)
+	_, err = rl.admit(ctx, watchAssignmentsMethod)
+	require.NoError(t, err)
+
+	_, err = rl.admit(peerContext("10.0.0.2:1234", nil), watchAssignmentsMethod)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	done1()
+	_, err = rl.admit(ctx, watchAssignmentsMethod)
+	require.NoError(t, err)
+}
+
+func TestRateLimitReload(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	rl := newTestRateLimiter(t, &now)
+	ctx := peerContext("10.0.0.1:1234", nil)
+
+	for i := 0; i < 2; i++ {
+		_, err := rl.admit(ctx, watchAssignmentsMethod)
+		require.NoError(t, err)
+	}
+
+	// The calls in progress are still counted with the reloaded limit.
+	rl.cfg.(*viper.Viper).Set("rateLimit.methods.FrontendService.WatchAssignments.maxConcurrent", 3)
+	rl.reload()
+	_, err := rl.admit(ctx, watchAssignmentsMethod)
+	require.NoError(t, err)
+	_, err = rl.admit(ctx, watchAssignmentsMethod)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestRateLimitCallerKey(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("rateLimit.metadataKey", "x-client-id")
+	const gatewayToken = "gateway-token"
+
+	tests := []struct {
+		description string
+		key         string
+		ctx         context.Context
+		want        string
+	}{
+		{
+			description: "peer",
+			key:         "peer",
+			ctx:         peerContext("10.0.0.1:1234", metadata.Pairs(xForwardedFor, "1.2.3.4")),
+			want:        "peer:10.0.0.1",
+		},
+		{
+			description: "peer proxied by the gateway",
+			key:         "peer",
+			ctx:         peerContext("127.0.0.1:1234", metadata.Pairs(xForwardedFor, "1.2.3.4, 10.0.0.2", gatewayProxyMetadata, gatewayToken)),
+			want:        "peer:10.0.0.2",
+		},
+		{
+			description: "local peer not proxied by the gateway",
+			key:         "peer",
+			ctx:         peerContext("127.0.0.1:1234", metadata.Pairs(xForwardedFor, "1.2.3.4")),
+			want:        "peer:127.0.0.1",
+		},
+		{
+			description: "peer with a forged gateway token",
+			key:         "peer",
+			ctx:         peerContext("127.0.0.1:1234", metadata.Pairs(xForwardedFor, "1.2.3.4", gatewayProxyMetadata, "forged")),
+			want:        "peer:127.0.0.1",
+		},
+		{
+			description: "subject",
+			key:         "subject",
+			ctx:         auth.NewContext(peerContext("10.0.0.1:1234", nil), &auth.Identity{Subject: "player"}),
+			want:        "subject:player",
+		},
+		{
+			description: "unauthenticated subject",
+			key:         "subject",
+			ctx:         peerContext("10.0.0.1:1234", nil),
+			want:        "peer:10.0.0.1",
+		},
+		{
+			description: "metadata",
+			key:         "metadata",
+			ctx:         peerContext("10.0.0.1:1234", metadata.Pairs("x-client-id", "client-1")),
+			want:        "metadata:client-1",
+		},
+		{
+			description: "global",
+			key:         "global",
+			ctx:         peerContext("10.0.0.1:1234", nil),
+			want:        "global",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.description, func(t *testing.T) {
+			l := methodLimits{key: tt.key}
+			require.Equal(t, tt.want, l.callerKey(tt.ctx, cfg, gatewayToken))
+		})
+	}
+}
+
+func TestRateLimitHTTPHandler(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	rl := newTestRateLimiter(t, &now)
+
+	var got string
+	handler := rl.HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		got = req.Header.Get(gatewayProxyHeader)
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/v1/frontendservice/tickets", nil)
+	req.Header.Set(gatewayProxyHeader, "forged")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, rl.gatewayToken, got)
+	require.NotEmpty(t, got)
+}
+
+func TestValidateRateLimits(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("rateLimit.methods.FrontendService.CreateTicket.rate", 2)
+	_, err := validateRateLimits(cfg)
+	require.NoError(t, err)
+
+	cfg.Set("rateLimit.methods.FrontendService.CreateTicket.burst", -1)
+	_, err = validateRateLimits(cfg)
+	require.Error(t, err)
+
+	cfg.Set("rateLimit.methods.FrontendService.CreateTicket.burst", 1)
+	cfg.Set("rateLimit.methods.FrontendService.CreateTicket.key", "client")
+	_, err = validateRateLimits(cfg)
+	require.Error(t, err)
+}
+
+func TestMethodConfigName(t *testing.T) {
+	require.Equal(t, "FrontendService.CreateTicket", methodConfigName(createTicketMethod))
+	require.Equal(t, "Health.Check", methodConfigName("/grpc.health.v1.Health/Check"))
+}
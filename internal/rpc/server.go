@@ -31,11 +31,10 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
-	"go.opencensus.io/plugin/ocgrpc"
-	"go.opencensus.io/plugin/ochttp"
-	"go.opencensus.io/plugin/ochttp/propagation/b3"
+	"go.opencensus.io/stats/view"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
+	"open-match.dev/open-match/internal/auth"
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/logging"
 	"open-match.dev/open-match/internal/telemetry"
@@ -80,6 +79,13 @@ type ServerParams struct {
 	// Private key in PEM format.
 	privateKeyFileData []byte
 
+	// authorizer enforces authentication and authorization, nil if disabled.
+	authorizer *auth.Authorizer
+	// rateLimiter enforces the rate limits of the methods, nil if disabled.
+	rateLimiter *rateLimiter
+	// drainer lets the streams end when the server stops.
+	drainer *drainer
+
 	enableRPCLogging        bool
 	enableRPCPayloadLogging bool
 	enableMetrics           bool
@@ -134,7 +140,20 @@ func NewServerParamsFromConfig(cfg config.View, prefix string, listen func(netwo
 		p.SetTLSConfiguration(rootPublicCertData, publicCertData, privateKeyData)
 	}
 
-	p.enableMetrics = cfg.GetBool(telemetry.ConfigNameEnableMetrics)
+	p.authorizer, err = auth.New(cfg)
+	if err != nil {
+		p.invalidate()
+		return nil, errors.Wrap(err, "cannot configure authentication")
+	}
+
+	p.rateLimiter, err = newRateLimiter(cfg)
+	if err != nil {
+		p.invalidate()
+		return nil, errors.Wrap(err, "cannot configure rate limits")
+	}
+
+	p.drainer.configure(cfg)
+	p.enableMetrics = telemetry.IsInstrumented(cfg)
 	p.enableRPCLogging = cfg.GetBool(ConfigNameEnableRPCLogging)
 	p.enableRPCPayloadLogging = logging.IsDebugEnabled(cfg)
 
@@ -143,12 +162,15 @@ func NewServerParamsFromConfig(cfg config.View, prefix string, listen func(netwo
 
 // NewServerParamsFromListeners returns server Params initialized with the ListenerHolder variables.
 func NewServerParamsFromListeners(grpcL net.Listener, proxyL net.Listener) *ServerParams {
+	d := newDrainer()
 	return &ServerParams{
-		ServeMux:             http.NewServeMux(),
-		handlersForGrpc:      []GrpcHandler{},
-		handlersForGrpcProxy: []GrpcProxyHandler{},
-		grpcListener:         grpcL,
-		grpcProxyListener:    proxyL,
+		ServeMux:               http.NewServeMux(),
+		handlersForGrpc:        []GrpcHandler{},
+		handlersForGrpcProxy:   []GrpcProxyHandler{},
+		handlersForHealthCheck: []func(context.Context) error{d.healthCheck},
+		grpcListener:           grpcL,
+		grpcProxyListener:      proxyL,
+		drainer:                d,
 	}
 }
 
@@ -163,6 +185,37 @@ func (p *ServerParams) SetTLSConfiguration(rootCaPublicCertificateFileData []byt
 	return p
 }
 
+// SetAuthorizer enforces authentication and authorization on all calls to the server.
+func (p *ServerParams) SetAuthorizer(a *auth.Authorizer) *ServerParams {
+	p.authorizer = a
+	return p
+}
+
+// Views returns the metric views of the enabled server features.
+func (p *ServerParams) Views() []*view.View {
+	if p.rateLimiter != nil {
+		return []*view.View{throttledCallsView}
+	}
+	return nil
+}
+
+// requestClientCertificates returns true if TLS clients should be asked for a certificate.
+func (p *ServerParams) requestClientCertificates() bool {
+	return p.authorizer != nil && p.authorizer.RequestClientCertificates()
+}
+
+// proxyHandler returns the handler of the HTTP proxy, authenticating requests if enabled.
+func (p *ServerParams) proxyHandler(proxyMux *runtime.ServeMux) http.Handler {
+	var handler http.Handler = proxyMux
+	if p.rateLimiter != nil {
+		handler = p.rateLimiter.HTTPHandler(handler)
+	}
+	if p.authorizer != nil {
+		handler = p.authorizer.HTTPHandler(handler)
+	}
+	return handler
+}
+
 // usingTLS returns true if a certificate is set.
 func (p *ServerParams) usingTLS() bool {
 	return len(p.publicCertificateFileData) > 0
@@ -199,16 +252,23 @@ func (p *ServerParams) invalidate() {
 // All HTTP traffic is served from a common http.ServeMux.
 type Server struct {
 	serverWithProxy grpcServerWithProxy
+	drainer         *drainer
+	// drained is false if streams were still running at the end of Drain.
+	drained bool
 }
 
 // grpcServerWithProxy this will go away when insecure.go and tls.go are merged into the same server.
 type grpcServerWithProxy interface {
 	start(*ServerParams) error
-	stop() error
+	// stop waits for the running calls to end if graceful is set, and
+	// cancels them otherwise.
+	stop(graceful bool) error
 }
 
 // Start the gRPC+HTTP(s) REST server.
 func (s *Server) Start(p *ServerParams) error {
+	s.drainer = p.drainer
+	s.drained = true
 	if p.usingTLS() {
 		s.serverWithProxy = newTLSServer(p.grpcListener, p.grpcProxyListener)
 	} else {
@@ -217,9 +277,21 @@ func (s *Server) Start(p *ServerParams) error {
 	return s.serverWithProxy.start(p)
 }
 
+// Drain lets the running streams end before the server is stopped. It
+// returns an error if some were still running after the drain timeout: Stop
+// then cancels them.
+func (s *Server) Drain() error {
+	if s.drainer == nil {
+		return nil
+	}
+	err := s.drainer.drain()
+	s.drained = err == nil
+	return err
+}
+
 // Stop the gRPC+HTTP(s) REST server.
 func (s *Server) Stop() error {
-	return s.serverWithProxy.stop()
+	return s.serverWithProxy.stop(s.drained)
 }
 
 type loggingHTTPHandler struct {
@@ -244,10 +316,7 @@ func (l *loggingHTTPHandler) ServeHTTP(w http.ResponseWriter, req *http.Request)
 
 func instrumentHTTPHandler(handler http.Handler, params *ServerParams) http.Handler {
 	if params.enableMetrics {
-		handler = &ochttp.Handler{
-			Handler:     handler,
-			Propagation: &b3.HTTPFormat{},
-		}
+		handler = telemetry.HTTPHandler(handler, "open-match")
 	}
 	if params.enableRPCLogging {
 		handler = &loggingHTTPHandler{
@@ -262,14 +331,32 @@ func newGRPCServerOptions(params *ServerParams) []grpc.ServerOption {
 	opts := []grpc.ServerOption{}
 	si := []grpc.StreamServerInterceptor{
 		grpc_recovery.StreamServerInterceptor(),
-		grpc_validator.StreamServerInterceptor(),
-		grpc_tracing.StreamServerInterceptor(),
+	}
+	if params.drainer != nil {
+		// Refuse the new streams once the server is draining.
+		si = append(si, params.drainer.streamServerInterceptor)
 	}
 	ui := []grpc.UnaryServerInterceptor{
 		grpc_recovery.UnaryServerInterceptor(),
+	}
+	if params.authorizer != nil {
+		// Reject unauthorized calls before the requests are validated or logged.
+		si = append(si, params.authorizer.StreamServerInterceptor())
+		ui = append(ui, params.authorizer.UnaryServerInterceptor())
+	}
+	if params.rateLimiter != nil {
+		// Limits may apply per authenticated caller.
+		si = append(si, params.rateLimiter.streamServerInterceptor)
+		ui = append(ui, params.rateLimiter.unaryServerInterceptor)
+	}
+	si = append(si,
+		grpc_validator.StreamServerInterceptor(),
+		grpc_tracing.StreamServerInterceptor(),
+	)
+	ui = append(ui,
 		grpc_validator.UnaryServerInterceptor(),
 		grpc_tracing.UnaryServerInterceptor(),
-	}
+	)
 	if params.enableRPCLogging {
 		grpcLogger := logrus.WithFields(logrus.Fields{
 			"app":       "openmatch",
@@ -292,7 +379,7 @@ func newGRPCServerOptions(params *ServerParams) []grpc.ServerOption {
 	si = append(si, serverStreamInterceptor)
 
 	if params.enableMetrics {
-		opts = append(opts, grpc.StatsHandler(&ocgrpc.ServerHandler{}))
+		opts = append(opts, grpc.StatsHandler(telemetry.GRPCServerHandler()))
 	}
 
 	return append(opts,
@@ -15,6 +15,7 @@
 package rpc
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -22,8 +23,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/auth"
 	"open-match.dev/open-match/internal/telemetry"
 	shellTesting "open-match.dev/open-match/internal/testing"
 	utilTesting "open-match.dev/open-match/internal/util/testing"
@@ -57,6 +63,78 @@ func TestStartStopServer(t *testing.T) {
 	runGrpcWithProxyTests(t, require, s.serverWithProxy, conn, httpClient, endpoint)
 }
 
+func TestServerAuthorization(t *testing.T) {
+	require := require.New(t)
+	grpcL := MustListen()
+	httpL := MustListen()
+	ff := &shellTesting.FakeFrontend{}
+
+	cfg := viper.New()
+	cfg.Set("api.auth.roles.game-client.permissions", []string{"/openmatch.FrontendService/CreateTicket"})
+	authorizer, err := auth.NewWithAuthenticators(cfg, auth.AuthenticatorFunc(func(c *auth.Credentials) (*auth.Identity, error) {
+		switch c.BearerToken {
+		case "":
+			return nil, nil
+		case "player":
+			return &auth.Identity{Subject: "player", Roles: []string{"game-client"}}, nil
+		case "director":
+			return &auth.Identity{Subject: "director", Roles: []string{"director"}}, nil
+		}
+		return nil, errors.New("unknown token")
+	}))
+	require.Nil(err)
+
+	params := NewServerParamsFromListeners(grpcL, httpL).SetAuthorizer(authorizer)
+	params.AddHandleFunc(func(s *grpc.Server) {
+		pb.RegisterFrontendServiceServer(s, ff)
+	}, pb.RegisterFrontendServiceHandlerFromEndpoint)
+	s := &Server{}
+	defer s.Stop()
+	require.Nil(s.Start(params))
+
+	conn, err := grpc.Dial(fmt.Sprintf(":%s", MustGetPortNumber(grpcL)), grpc.WithInsecure())
+	require.Nil(err)
+	feClient := pb.NewFrontendServiceClient(conn)
+	ctx := utilTesting.NewContext(t)
+
+	grpcCodes := map[string]codes.Code{
+		"":         codes.Unauthenticated,
+		"forged":   codes.Unauthenticated,
+		"director": codes.PermissionDenied,
+		"player":   codes.OK,
+	}
+	for token, want := range grpcCodes {
+		callCtx := ctx
+		if token != "" {
+			callCtx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		}
+		_, err = feClient.CreateTicket(callCtx, &pb.CreateTicketRequest{})
+		require.Equal(want.String(), status.Convert(err).Code().String(), token)
+	}
+
+	endpoint := fmt.Sprintf("http://localhost:%s", MustGetPortNumber(httpL))
+	httpClient := &http.Client{
+		Timeout: time.Second,
+	}
+	httpCodes := map[string]int{
+		"":         http.StatusUnauthorized,
+		"forged":   http.StatusUnauthorized,
+		"director": http.StatusForbidden,
+		"player":   http.StatusOK,
+	}
+	for token, want := range httpCodes {
+		httpReq, err := http.NewRequest(http.MethodPost, endpoint+"/v1/frontendservice/tickets", strings.NewReader("{}"))
+		require.Nil(err)
+		if token != "" {
+			httpReq.Header.Set("Authorization", "Bearer "+token)
+		}
+		httpResp, err := httpClient.Do(httpReq)
+		require.Nil(err)
+		httpResp.Body.Close()
+		require.Equal(want, httpResp.StatusCode, token)
+	}
+}
+
 func runGrpcWithProxyTests(t *testing.T, require *require.Assertions, s grpcServerWithProxy, conn *grpc.ClientConn, httpClient *http.Client, endpoint string) {
 	ctx := utilTesting.NewContext(t)
 	feClient := pb.NewFrontendServiceClient(conn)
@@ -96,5 +174,5 @@ func runGrpcWithProxyTests(t *testing.T, require *require.Assertions, s grpcServ
 	require.Equal(200, httpResp.StatusCode)
 	require.Equal("ok", string(body))
 
-	s.stop()
+	s.stop(true)
 }
@@ -79,7 +79,16 @@ func (s *tlsServer) start(params *ServerParams) error {
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	creds := credentials.NewServerTLSFromCert(grpcTLSCertificate)
+	// Client certificates are verified if given, the authorizer decides whether they are required.
+	clientAuth := tls.NoClientCert
+	if params.requestClientCertificates() {
+		clientAuth = tls.VerifyClientCertIfGiven
+	}
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{*grpcTLSCertificate},
+		ClientCAs:    rootCaCert,
+		ClientAuth:   clientAuth,
+	})
 	serverOpts := newGRPCServerOptions(params)
 	serverOpts = append(serverOpts, grpc.Creds(creds))
 	s.grpcServer = grpc.NewServer(serverOpts...)
@@ -113,16 +122,15 @@ func (s *tlsServer) start(params *ServerParams) error {
 
 	// Bind HTTPS handlers
 	s.httpMux.Handle(telemetry.HealthCheckEndpoint, telemetry.NewHealthCheck(params.handlersForHealthCheck))
-	s.httpMux.Handle("/", s.proxyMux)
+	s.httpMux.Handle("/", params.proxyHandler(s.proxyMux))
 	s.httpServer = &http.Server{
 		Addr:    s.httpListener.Addr().String(),
 		Handler: instrumentHTTPHandler(s.httpMux, params),
 		TLSConfig: &tls.Config{
 			Certificates: []tls.Certificate{*grpcTLSCertificate},
 			ClientCAs:    rootCaCert,
-			// Commented as open-match does not support mutual authentication yet
-			// ClientAuth:   tls.RequireAndVerifyClientCert,
-			NextProtos: []string{http2WithTLSVersionID}, // https://github.com/grpc-ecosystem/grpc-gateway/issues/220
+			ClientAuth:   clientAuth,
+			NextProtos:   []string{http2WithTLSVersionID}, // https://github.com/grpc-ecosystem/grpc-gateway/issues/220
 		},
 	}
 	go func() {
@@ -138,10 +146,14 @@ func (s *tlsServer) start(params *ServerParams) error {
 	return nil
 }
 
-func (s *tlsServer) stop() error {
+func (s *tlsServer) stop(graceful bool) error {
 	// the servers also close their respective listeners.
 	err := s.httpServer.Shutdown(context.Background())
-	s.grpcServer.GracefulStop()
+	if graceful {
+		s.grpcServer.GracefulStop()
+	} else {
+		s.grpcServer.Stop()
+	}
 	return err
 }
 
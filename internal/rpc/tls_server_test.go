@@ -101,7 +101,7 @@ func runTestStartStopTLSServer(t *testing.T, tp *tlsServerTestParams) {
 
 	serverParams.SetTLSConfiguration(tp.rootPublicCertificateFileData, tp.publicCertificateFileData, tp.privateKeyFileData)
 	s := newTLSServer(serverParams.grpcListener, serverParams.grpcProxyListener)
-	defer s.stop()
+	defer s.stop(true)
 
 	err := s.start(serverParams)
 	require.Nil(err)
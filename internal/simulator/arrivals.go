@@ -0,0 +1,120 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"math/rand"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/pkg/errors"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// Arrival is a ticket arriving at Offset since the start of the simulation.
+type Arrival struct {
+	Offset time.Duration
+	// Ticket is nil if the ticket is generated by the scenario.
+	Ticket *pb.Ticket
+}
+
+// Arrivals generates the arrivals of the tickets, in the order of their
+// offsets.
+type Arrivals interface {
+	// Next returns the next arrival, or false once there are no more.
+	Next() (Arrival, bool, error)
+}
+
+// PoissonArrivals returns arrivals at rate tickets per second on average, with
+// exponentially distributed inter-arrival times.
+func PoissonArrivals(rate float64, r *rand.Rand) Arrivals {
+	return &randomArrivals{
+		interval: func() time.Duration {
+			return time.Duration(r.ExpFloat64() / rate * float64(time.Second))
+		},
+	}
+}
+
+// UniformArrivals returns arrivals at exactly rate tickets per second.
+func UniformArrivals(rate float64) Arrivals {
+	return &randomArrivals{
+		interval: func() time.Duration {
+			return time.Duration(float64(time.Second) / rate)
+		},
+	}
+}
+
+type randomArrivals struct {
+	interval func() time.Duration
+	offset   time.Duration
+}
+
+func (a *randomArrivals) Next() (Arrival, bool, error) {
+	a.offset += a.interval()
+	return Arrival{Offset: a.offset}, true, nil
+}
+
+// traceLine is a line of a recorded trace, for example:
+//
+//	{"offset": 1.25, "ticket": {"searchFields": {"tags": ["mode.ctf"]}}}
+//
+// The offset is in seconds. The ticket is optional.
+type traceLine struct {
+	Offset float64         `json:"offset"`
+	Ticket json.RawMessage `json:"ticket"`
+}
+
+// TraceArrivals reads the arrivals from a recorded trace, a JSON object per
+// line with the offset of the arrival in seconds, and optionally the ticket.
+func TraceArrivals(r io.Reader) Arrivals {
+	return &traceArrivals{scanner: bufio.NewScanner(r)}
+}
+
+type traceArrivals struct {
+	scanner *bufio.Scanner
+	line    int
+	offset  time.Duration
+}
+
+func (a *traceArrivals) Next() (Arrival, bool, error) {
+	for a.scanner.Scan() {
+		a.line++
+		line := bytes.TrimSpace(a.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var l traceLine
+		if err := json.Unmarshal(line, &l); err != nil {
+			return Arrival{}, false, errors.Wrapf(err, "invalid trace line %d", a.line)
+		}
+		arrival := Arrival{Offset: time.Duration(l.Offset * float64(time.Second))}
+		if arrival.Offset < a.offset {
+			return Arrival{}, false, errors.Errorf("trace line %d: offset %v is before the previous one", a.line, arrival.Offset)
+		}
+		a.offset = arrival.Offset
+		if len(l.Ticket) > 0 && string(l.Ticket) != "null" {
+			arrival.Ticket = &pb.Ticket{}
+			if err := jsonpb.Unmarshal(bytes.NewReader(l.Ticket), arrival.Ticket); err != nil {
+				return Arrival{}, false, errors.Wrapf(err, "invalid ticket on trace line %d", a.line)
+			}
+		}
+		return arrival, true, nil
+	}
+	return Arrival{}, false, errors.Wrap(a.scanner.Err(), "cannot read trace")
+}
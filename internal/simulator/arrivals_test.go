@@ -0,0 +1,83 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniformArrivals(t *testing.T) {
+	a := UniformArrivals(4)
+	for i := 1; i <= 3; i++ {
+		arrival, ok, err := a.Next()
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, time.Duration(i)*250*time.Millisecond, arrival.Offset)
+		require.Nil(t, arrival.Ticket)
+	}
+}
+
+func TestPoissonArrivals(t *testing.T) {
+	a := PoissonArrivals(100, rand.New(rand.NewSource(1)))
+	var last Arrival
+	for i := 0; i < 10000; i++ {
+		arrival, ok, err := a.Next()
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.True(t, arrival.Offset >= last.Offset)
+		last = arrival
+	}
+	// 10000 arrivals at 100 per second take about 100 seconds.
+	require.InDelta(t, 100, last.Offset.Seconds(), 5)
+}
+
+func TestTraceArrivals(t *testing.T) {
+	a := TraceArrivals(strings.NewReader(`
+{"offset": 0.5}
+{"offset": 1.5, "ticket": {"searchFields": {"tags": ["mode.ctf"]}}}
+`))
+
+	arrival, ok, err := a.Next()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 500*time.Millisecond, arrival.Offset)
+	require.Nil(t, arrival.Ticket)
+
+	arrival, ok, err = a.Next()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, 1500*time.Millisecond, arrival.Offset)
+	require.Equal(t, []string{"mode.ctf"}, arrival.Ticket.GetSearchFields().GetTags())
+
+	_, ok, err = a.Next()
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestTraceArrivalsErrors(t *testing.T) {
+	_, _, err := TraceArrivals(strings.NewReader("not json")).Next()
+	require.Error(t, err)
+
+	a := TraceArrivals(strings.NewReader("{\"offset\": 2}\n{\"offset\": 1}\n"))
+	_, _, err = a.Next()
+	require.NoError(t, err)
+	_, _, err = a.Next()
+	require.Error(t, err)
+}
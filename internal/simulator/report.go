@@ -0,0 +1,145 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Report summarizes a simulation.
+type Report struct {
+	Scenario         string             `json:"scenario"`
+	SimulatedSeconds float64            `json:"simulatedSeconds"`
+	WallClockSeconds float64            `json:"wallClockSeconds"`
+	Cycles           int                `json:"cycles"`
+	FetchErrors      int                `json:"fetchErrors"`
+	TicketsCreated   int                `json:"ticketsCreated"`
+	TicketsMatched   int                `json:"ticketsMatched"`
+	TicketsUnmatched int                `json:"ticketsUnmatched"`
+	Matches          int                `json:"matches"`
+	BackfillMatches  int                `json:"backfillMatches"`
+	MatchesPerMinute float64            `json:"matchesPerMinute"`
+	TicketsPerSecond float64            `json:"ticketsMatchedPerSecond"`
+	WaitSeconds      Summary            `json:"waitSeconds"`
+	TicketsPerMatch  Summary            `json:"ticketsPerMatch"`
+	EvaluationScores Summary            `json:"evaluationScores"`
+	DoubleArgSpreads map[string]Summary `json:"doubleArgSpreads,omitempty"`
+}
+
+// Summary summarizes the distribution of a value.
+type Summary struct {
+	Count int     `json:"count"`
+	Mean  float64 `json:"mean"`
+	Min   float64 `json:"min"`
+	P50   float64 `json:"p50"`
+	P90   float64 `json:"p90"`
+	P99   float64 `json:"p99"`
+	Max   float64 `json:"max"`
+}
+
+// summarize returns the Summary of values, which it sorts.
+func summarize(values []float64) Summary {
+	if len(values) == 0 {
+		return Summary{}
+	}
+	sort.Float64s(values)
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return Summary{
+		Count: len(values),
+		Mean:  sum / float64(len(values)),
+		Min:   values[0],
+		P50:   percentile(values, 0.5),
+		P90:   percentile(values, 0.9),
+		P99:   percentile(values, 0.99),
+		Max:   values[len(values)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile p of the sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// WriteJSON writes r as an indented JSON object.
+func (r *Report) WriteJSON(w io.Writer) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return errors.Wrap(e.Encode(r), "cannot write the report")
+}
+
+// WriteCSV writes r as metric,value rows. The metrics of the summaries are
+// suffixed with the statistic, for example waitSeconds.p90.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	rows := [][]string{
+		{"metric", "value"},
+		{"scenario", r.Scenario},
+	}
+	add := func(metric string, v float64) {
+		rows = append(rows, []string{metric, strconv.FormatFloat(v, 'g', -1, 64)})
+	}
+	addSummary := func(metric string, s Summary) {
+		add(metric+".count", float64(s.Count))
+		add(metric+".mean", s.Mean)
+		add(metric+".min", s.Min)
+		add(metric+".p50", s.P50)
+		add(metric+".p90", s.P90)
+		add(metric+".p99", s.P99)
+		add(metric+".max", s.Max)
+	}
+
+	add("simulatedSeconds", r.SimulatedSeconds)
+	add("wallClockSeconds", r.WallClockSeconds)
+	add("cycles", float64(r.Cycles))
+	add("fetchErrors", float64(r.FetchErrors))
+	add("ticketsCreated", float64(r.TicketsCreated))
+	add("ticketsMatched", float64(r.TicketsMatched))
+	add("ticketsUnmatched", float64(r.TicketsUnmatched))
+	add("matches", float64(r.Matches))
+	add("backfillMatches", float64(r.BackfillMatches))
+	add("matchesPerMinute", r.MatchesPerMinute)
+	add("ticketsMatchedPerSecond", r.TicketsPerSecond)
+	addSummary("waitSeconds", r.WaitSeconds)
+	addSummary("ticketsPerMatch", r.TicketsPerMatch)
+	addSummary("evaluationScores", r.EvaluationScores)
+
+	args := make([]string, 0, len(r.DoubleArgSpreads))
+	for arg := range r.DoubleArgSpreads {
+		args = append(args, arg)
+	}
+	sort.Strings(args)
+	for _, arg := range args {
+		addSummary("doubleArgSpreads."+arg, r.DoubleArgSpreads[arg])
+	}
+
+	if err := cw.WriteAll(rows); err != nil {
+		return errors.Wrap(err, "cannot write the report")
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarize(t *testing.T) {
+	require.Equal(t, Summary{}, summarize(nil))
+
+	values := []float64{}
+	for i := 100; i >= 1; i-- {
+		values = append(values, float64(i))
+	}
+	require.Equal(t, Summary{
+		Count: 100,
+		Mean:  50.5,
+		Min:   1,
+		P50:   50,
+		P90:   90,
+		P99:   99,
+		Max:   100,
+	}, summarize(values))
+}
+
+func TestReportWrite(t *testing.T) {
+	r := &Report{
+		Scenario:         "firstmatch",
+		Matches:          3,
+		WaitSeconds:      summarize([]float64{1, 2, 3}),
+		DoubleArgSpreads: map[string]Summary{"mmr": summarize([]float64{10})},
+	}
+
+	var b bytes.Buffer
+	require.NoError(t, r.WriteJSON(&b))
+	var got Report
+	require.NoError(t, json.Unmarshal(b.Bytes(), &got))
+	require.Equal(t, *r, got)
+
+	b.Reset()
+	require.NoError(t, r.WriteCSV(&b))
+	csv := b.String()
+	require.True(t, strings.HasPrefix(csv, "metric,value\nscenario,firstmatch\n"))
+	require.Contains(t, csv, "matches,3\n")
+	require.Contains(t, csv, "waitSeconds.p50,2\n")
+	require.Contains(t, csv, "doubleArgSpreads.mmr.max,10\n")
+}
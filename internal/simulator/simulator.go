@@ -0,0 +1,409 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulator runs matchmaking scenarios against Open Match running
+// in-process on a simulated clock, so that hours of matchmaking can be
+// evaluated in minutes on a laptop.
+package simulator
+
+import (
+	"context"
+	"io"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"open-match.dev/open-match/pkg/evaluator"
+	"open-match.dev/open-match/pkg/matchfunction"
+	"open-match.dev/open-match/pkg/omtest"
+	"open-match.dev/open-match/pkg/omtest/memredis"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+var logger = logrus.WithFields(logrus.Fields{
+	"app":       "openmatch",
+	"component": "simulator",
+})
+
+// registrationInterval is the registration window of the synchronizer, in
+// simulated time.
+const registrationInterval = 200 * time.Millisecond
+
+// GameScenario defines what tickets look like, and how they should be
+// matched. The scenarios of examples/scale/scenarios implement it.
+type GameScenario interface {
+	// Ticket creates a new ticket, with randomized parameters.
+	Ticket() *pb.Ticket
+
+	// Backfill creates a new backfill, with randomized parameters.
+	Backfill() *pb.Backfill
+
+	// Profiles lists all of the profiles that should run.
+	Profiles() []*pb.MatchProfile
+
+	// MatchFunction is the custom logic implementation of the match function.
+	MatchFunction(p *pb.MatchProfile, poolBackfills map[string][]*pb.Backfill, poolTickets map[string][]*pb.Ticket) ([]*pb.Match, error)
+
+	// Evaluate is the custom logic implementation of the evaluator.
+	Evaluate(stream pb.Evaluator_EvaluateServer) error
+}
+
+// Params of a simulation.
+type Params struct {
+	// Name of the game scenario, in the report.
+	Name string
+	// Scenario is the simulated game scenario.
+	Scenario GameScenario
+	// Arrivals generates the tickets.
+	Arrivals Arrivals
+	// Duration is the simulated time during which tickets arrive.
+	Duration time.Duration
+	// CycleInterval is the simulated time between the starts of two fetch
+	// cycles.
+	CycleInterval time.Duration
+	// Backfills is the number of backfills created at the start.
+	Backfills int
+	// DoubleArgs are the double args of the tickets whose spread within each
+	// match is reported.
+	DoubleArgs []string
+	// Config overrides the configuration of Open Match.
+	Config map[string]interface{}
+}
+
+// Run simulates the matchmaking of p and returns its report.
+func Run(ctx context.Context, p *Params) (*Report, error) {
+	if p.Scenario == nil {
+		return nil, errors.New("the game scenario is required")
+	}
+	if p.CycleInterval < registrationInterval {
+		return nil, errors.Errorf("the cycle interval must be at least the registration interval of %v", registrationInterval)
+	}
+	gs := p.Scenario
+
+	start := time.Now()
+	clock := omtest.NewFakeClock(start)
+	// Buffered for the match function calls of a cycle, one per profile.
+	registered := make(chan string, len(gs.Profiles()))
+	opts := []omtest.Option{
+		omtest.WithRedisServer(memredis.Start),
+		omtest.WithClock(clock),
+		omtest.WithConfig("registrationInterval", registrationInterval.String()),
+		omtest.WithMatchFunction(matchFunction(gs, registered)),
+		omtest.WithEvaluator(evaluate(gs)),
+	}
+	for k, v := range p.Config {
+		opts = append(opts, omtest.WithConfig(k, v))
+	}
+	om, err := omtest.New(opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot start open match")
+	}
+	defer func() {
+		if err := om.Close(); err != nil {
+			logger.WithError(err).Warning("cannot stop open match")
+		}
+	}()
+
+	s := &simulation{
+		params:     p,
+		scenario:   gs,
+		om:         om,
+		clock:      clock,
+		start:      start,
+		registered: registered,
+		arrivals:   make(map[string]time.Time),
+		spreads:    make(map[string][]float64),
+	}
+	return s.run(ctx)
+}
+
+type simulation struct {
+	params   *Params
+	scenario GameScenario
+	om       *omtest.OM
+	clock    *omtest.FakeClock
+	start    time.Time
+	// registered receives the names of the profiles whose FetchMatches call
+	// registered to the synchronizer cycle, once their match function runs.
+	registered chan string
+
+	// arrivals are the arrival times of the unmatched tickets, by id.
+	arrivals map[string]time.Time
+	next     *Arrival
+
+	report          Report
+	waits           []float64
+	ticketsPerMatch []float64
+	scores          []float64
+	spreads         map[string][]float64
+}
+
+func (s *simulation) run(ctx context.Context) (*Report, error) {
+	wallStart := time.Now()
+
+	for i := 0; i < s.params.Backfills; i++ {
+		if _, err := s.om.Frontend().CreateBackfill(ctx, &pb.CreateBackfillRequest{Backfill: s.scenario.Backfill()}); err != nil {
+			return nil, errors.Wrap(err, "cannot create backfill")
+		}
+	}
+
+	end := s.start.Add(s.params.Duration)
+	for s.clock.Now().Before(end) {
+		cycleEnd := s.clock.Now().Add(s.params.CycleInterval)
+		if err := s.createArrivedTickets(ctx); err != nil {
+			return nil, err
+		}
+		matches, err := s.fetchMatches(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.record(ctx, matches); err != nil {
+			return nil, err
+		}
+		s.report.Cycles++
+		if now := s.clock.Now(); now.Before(cycleEnd) {
+			s.om.AdvanceTime(cycleEnd.Sub(now))
+		}
+	}
+
+	r := s.report
+	r.Scenario = s.params.Name
+	r.SimulatedSeconds = s.clock.Since(s.start).Seconds()
+	r.WallClockSeconds = time.Since(wallStart).Seconds()
+	r.TicketsUnmatched = len(s.arrivals)
+	if r.SimulatedSeconds > 0 {
+		r.MatchesPerMinute = float64(r.Matches) / r.SimulatedSeconds * 60
+		r.TicketsPerSecond = float64(r.TicketsMatched) / r.SimulatedSeconds
+	}
+	r.WaitSeconds = summarize(s.waits)
+	r.TicketsPerMatch = summarize(s.ticketsPerMatch)
+	r.EvaluationScores = summarize(s.scores)
+	if len(s.params.DoubleArgs) > 0 {
+		r.DoubleArgSpreads = make(map[string]Summary)
+		for _, arg := range s.params.DoubleArgs {
+			r.DoubleArgSpreads[arg] = summarize(s.spreads[arg])
+		}
+	}
+	return &r, nil
+}
+
+// createArrivedTickets creates the tickets which arrived until now.
+func (s *simulation) createArrivedTickets(ctx context.Context) error {
+	now := s.clock.Now()
+	for {
+		if s.next == nil {
+			a, ok, err := s.params.Arrivals.Next()
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+			s.next = &a
+		}
+		arrival := s.start.Add(s.next.Offset)
+		if arrival.After(now) || !arrival.Before(s.start.Add(s.params.Duration)) {
+			return nil
+		}
+
+		ticket := s.next.Ticket
+		if ticket == nil {
+			ticket = s.scenario.Ticket()
+		}
+		created, err := s.om.CreateTickets(ctx, ticket)
+		if err != nil {
+			return err
+		}
+		s.arrivals[created[0].GetId()] = arrival
+		s.report.TicketsCreated++
+		s.next = nil
+	}
+}
+
+// fetchMatches runs a fetch cycle for all the profiles of the scenario. The
+// simulated clock is advanced past the registration window of the
+// synchronizer once all the calls registered to the cycle, or failed.
+func (s *simulation) fetchMatches(ctx context.Context) ([]*pb.Match, error) {
+	profiles := s.scenario.Profiles()
+	type result struct {
+		profile string
+		matches []*pb.Match
+		err     error
+	}
+	results := make(chan result, len(profiles))
+	for _, profile := range profiles {
+		go func(profile *pb.MatchProfile) {
+			matches, err := s.om.FetchMatches(ctx, profile)
+			results <- result{profile.GetName(), matches, err}
+		}(profile)
+	}
+
+	var matches []*pb.Match
+	waiting := make(map[string]bool, len(profiles))
+	for _, p := range profiles {
+		waiting[p.GetName()] = true
+	}
+	advanced := false
+	for pending := len(profiles); pending > 0; {
+		if !advanced && len(waiting) == 0 {
+			s.om.AdvanceTime(registrationInterval)
+			advanced = true
+		}
+		select {
+		case name := <-s.registered:
+			delete(waiting, name)
+		case r := <-results:
+			pending--
+			delete(waiting, r.profile)
+			if r.err != nil {
+				s.report.FetchErrors++
+				logger.WithError(r.err).Debug("fetch matches failed")
+				continue
+			}
+			matches = append(matches, r.matches...)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	// The registrations of the calls whose result was received first are
+	// not carried to the next cycle.
+	for {
+		select {
+		case <-s.registered:
+		default:
+			return matches, nil
+		}
+	}
+}
+
+// record records the quality of the matches, then assigns and deletes their
+// tickets.
+func (s *simulation) record(ctx context.Context, matches []*pb.Match) error {
+	if len(matches) == 0 {
+		return nil
+	}
+	now := s.clock.Now()
+	for _, m := range matches {
+		s.report.Matches++
+		if m.GetBackfill() != nil {
+			s.report.BackfillMatches++
+		}
+		s.ticketsPerMatch = append(s.ticketsPerMatch, float64(len(m.GetTickets())))
+		if score, err := evaluator.Score(m); err == nil && !math.IsInf(score, -1) {
+			s.scores = append(s.scores, score)
+		}
+		for _, arg := range s.params.DoubleArgs {
+			if spread, ok := doubleArgSpread(m, arg); ok {
+				s.spreads[arg] = append(s.spreads[arg], spread)
+			}
+		}
+		for _, t := range m.GetTickets() {
+			arrival, ok := s.arrivals[t.GetId()]
+			if !ok {
+				continue
+			}
+			delete(s.arrivals, t.GetId())
+			s.report.TicketsMatched++
+			s.waits = append(s.waits, now.Sub(arrival).Seconds())
+		}
+	}
+
+	if err := s.om.AssignTickets(ctx, "simulated", matches...); err != nil {
+		logger.WithError(err).Debug("cannot assign the tickets of some matches")
+	}
+	for _, m := range matches {
+		for _, t := range m.GetTickets() {
+			if _, err := s.om.Frontend().DeleteTicket(ctx, &pb.DeleteTicketRequest{TicketId: t.GetId()}); err != nil {
+				return errors.Wrapf(err, "cannot delete ticket %s", t.GetId())
+			}
+		}
+	}
+	return nil
+}
+
+// doubleArgSpread returns the difference between the largest and the smallest
+// value of arg in the tickets of m.
+func doubleArgSpread(m *pb.Match, arg string) (float64, bool) {
+	min, max := math.Inf(1), math.Inf(-1)
+	n := 0
+	for _, t := range m.GetTickets() {
+		v, ok := t.GetSearchFields().GetDoubleArgs()[arg]
+		if !ok {
+			continue
+		}
+		min, max = math.Min(min, v), math.Max(max, v)
+		n++
+	}
+	if n < 2 {
+		return 0, false
+	}
+	return max - min, true
+}
+
+// matchFunction adapts the match function of a scenario. The match function
+// runs once the FetchMatches call registered to the synchronizer cycle, which
+// is reported on registered.
+func matchFunction(gs GameScenario, registered chan<- string) matchfunction.MatchFunction {
+	return func(ctx context.Context, profile *pb.MatchProfile, pools map[string][]*pb.Ticket, backfillPools map[string][]*pb.Backfill) ([]*pb.Match, error) {
+		select {
+		case registered <- profile.GetName():
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		return gs.MatchFunction(profile, backfillPools, pools)
+	}
+}
+
+// evaluate adapts the streaming evaluator of a scenario.
+func evaluate(gs GameScenario) evaluator.Evaluator {
+	return func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+		return gs.Evaluate(&evaluateStream{ctx: ctx, in: in, out: out})
+	}
+}
+
+// evaluateStream is a pb.Evaluator_EvaluateServer reading the matches from in
+// and writing the accepted match IDs to out.
+type evaluateStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	in  <-chan *pb.Match
+	out chan<- string
+}
+
+func (s *evaluateStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *evaluateStream) Recv() (*pb.EvaluateRequest, error) {
+	select {
+	case m, ok := <-s.in:
+		if !ok {
+			return nil, io.EOF
+		}
+		return &pb.EvaluateRequest{Match: m}, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func (s *evaluateStream) Send(resp *pb.EvaluateResponse) error {
+	select {
+	case s.out <- resp.GetMatchId():
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
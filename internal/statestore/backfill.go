@@ -72,7 +72,7 @@ func (rb *redisBackend) CreateBackfill(ctx context.Context, backfill *pb.Backfil
 		return status.Errorf(codes.AlreadyExists, "backfill already exists, id: %s", backfill.GetId())
 	}
 
-	return doUpdateAcknowledgmentTimestamp(redisConn, backfill.GetId())
+	return doUpdateAcknowledgmentTimestamp(redisConn, backfill.GetId(), rb.clock.Now())
 }
 
 // GetBackfill gets the Backfill with the specified id from state storage. This method fails if the Backfill does not exist. Returns the Backfill and associated ticketIDs if they exist.
@@ -193,7 +193,7 @@ func (rb *redisBackend) UpdateBackfill(ctx context.Context, backfill *pb.Backfil
 	}
 	defer handleConnectionClose(&redisConn)
 
-	expired, err := isBackfillExpired(redisConn, backfill.Id, getBackfillReleaseTimeout(rb.cfg))
+	expired, err := isBackfillExpired(redisConn, backfill.Id, getBackfillReleaseTimeout(rb.cfg), rb.clock.Now())
 	if err != nil {
 		return err
 	}
@@ -222,14 +222,14 @@ func (rb *redisBackend) UpdateBackfill(ctx context.Context, backfill *pb.Backfil
 	return nil
 }
 
-func isBackfillExpired(conn redis.Conn, id string, ttl time.Duration) (bool, error) {
+func isBackfillExpired(conn redis.Conn, id string, ttl time.Duration, now time.Time) (bool, error) {
 	lastAckTime, err := redis.Float64(conn.Do("ZSCORE", backfillLastAckTime, id))
 	if err != nil {
 		return false, status.Errorf(codes.Internal, "%v",
 			errors.Wrapf(err, "failed to get backfill's last acknowledgement time, id: %s", id))
 	}
 
-	endTime := time.Now().Add(-ttl).UnixNano()
+	endTime := now.Add(-ttl).UnixNano()
 	return int64(lastAckTime) < endTime, nil
 }
 
@@ -285,6 +285,63 @@ func (rb *redisBackend) DeleteBackfillCompletely(ctx context.Context, id string)
 	return nil
 }
 
+// AcknowledgeBackfill updates the acknowledgment time of the Backfill, then assigns its
+// tickets, deindexes them and removes them from the Backfill. Returns the Backfill and
+// the assigned tickets.
+func (rb *redisBackend) AcknowledgeBackfill(ctx context.Context, id string, assignment *pb.Assignment) (*pb.Backfill, []*pb.Ticket, error) {
+	m := rb.NewMutex(id)
+	err := m.Lock(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		if _, err = m.Unlock(ctx); err != nil {
+			logger.WithError(err).Error("error on mutex unlock")
+		}
+	}()
+
+	bf, associatedTickets, err := rb.GetBackfill(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = rb.UpdateAcknowledgmentTimestamp(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tickets := make([]*pb.Ticket, 0)
+	if len(associatedTickets) == 0 {
+		return bf, tickets, nil
+	}
+
+	resp, tickets, err := rb.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{TicketIds: associatedTickets, Assignment: assignment}},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// log errors returned from UpdateAssignments to track tickets with NotFound errors
+	for _, f := range resp.GetFailures() {
+		logger.Errorf("failed to assign ticket %s, cause %d", f.TicketId, f.Cause)
+	}
+	for _, ticketID := range associatedTickets {
+		err = rb.DeindexTicket(ctx, ticketID)
+		// Try to deindex all input tickets. Log without returning an error if the deindexing operation failed.
+		if err != nil {
+			logger.WithError(err).Errorf("failed to deindex ticket %s after updating the assignments", ticketID)
+		}
+	}
+
+	// Remove all tickets associated with backfill, because unassigned tickets are not found only
+	err = rb.UpdateBackfill(ctx, bf, []string{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return bf, tickets, nil
+}
+
 func (rb *redisBackend) cleanupWorker(ctx context.Context, backfillIDsCh <-chan string, wg *sync.WaitGroup) {
 	var err error
 	for id := range backfillIDsCh {
@@ -332,7 +389,7 @@ func (rb *redisBackend) UpdateAcknowledgmentTimestamp(ctx context.Context, id st
 	}
 	defer handleConnectionClose(&redisConn)
 
-	expired, err := isBackfillExpired(redisConn, id, getBackfillReleaseTimeout(rb.cfg))
+	expired, err := isBackfillExpired(redisConn, id, getBackfillReleaseTimeout(rb.cfg), rb.clock.Now())
 	if err != nil {
 		return err
 	}
@@ -341,11 +398,11 @@ func (rb *redisBackend) UpdateAcknowledgmentTimestamp(ctx context.Context, id st
 		return status.Errorf(codes.Unavailable, "can not acknowledge an expired backfill, id: %s", id)
 	}
 
-	return doUpdateAcknowledgmentTimestamp(redisConn, id)
+	return doUpdateAcknowledgmentTimestamp(redisConn, id, rb.clock.Now())
 }
 
-func doUpdateAcknowledgmentTimestamp(conn redis.Conn, backfillID string) error {
-	currentTime := time.Now().UnixNano()
+func doUpdateAcknowledgmentTimestamp(conn redis.Conn, backfillID string, now time.Time) error {
+	currentTime := now.UnixNano()
 
 	_, err := conn.Do("ZADD", backfillLastAckTime, currentTime, backfillID)
 	if err != nil {
@@ -365,7 +422,7 @@ func (rb *redisBackend) GetExpiredBackfillIDs(ctx context.Context) ([]string, er
 	defer handleConnectionClose(&redisConn)
 
 	ttl := getBackfillReleaseTimeout(rb.cfg)
-	curTime := time.Now()
+	curTime := rb.clock.Now()
 	endTimeInt := curTime.Add(-ttl).UnixNano()
 	startTimeInt := 0
 
@@ -432,7 +489,7 @@ func (rb *redisBackend) GetIndexedBackfills(ctx context.Context) (map[string]int
 	defer handleConnectionClose(&redisConn)
 
 	ttl := getBackfillReleaseTimeout(rb.cfg)
-	curTime := time.Now()
+	curTime := rb.clock.Now()
 	endTimeInt := curTime.Add(time.Hour).UnixNano()
 	startTimeInt := curTime.Add(-ttl).UnixNano()
 
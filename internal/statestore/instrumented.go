@@ -53,6 +53,12 @@ func (is *instrumentedService) DeleteTicket(ctx context.Context, id string) erro
 	return is.s.DeleteTicket(ctx, id)
 }
 
+func (is *instrumentedService) UpdateTicket(ctx context.Context, ticket *pb.Ticket) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.UpdateTicket")
+	defer span.End()
+	return is.s.UpdateTicket(ctx, ticket)
+}
+
 func (is *instrumentedService) IndexTicket(ctx context.Context, ticket *pb.Ticket) error {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.IndexTicket")
 	defer span.End()
@@ -71,7 +77,7 @@ func (is *instrumentedService) GetTickets(ctx context.Context, ids []string) ([]
 	return is.s.GetTickets(ctx, ids)
 }
 
-func (is *instrumentedService) GetIndexedIDSet(ctx context.Context) (map[string]struct{}, error) {
+func (is *instrumentedService) GetIndexedIDSet(ctx context.Context) (map[string]int, error) {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetIndexedIDSet")
 	defer span.End()
 	return is.s.GetIndexedIDSet(ctx)
@@ -89,6 +95,18 @@ func (is *instrumentedService) GetAssignments(ctx context.Context, id string, ca
 	return is.s.GetAssignments(ctx, id, callback)
 }
 
+func (is *instrumentedService) AcknowledgeAssignment(ctx context.Context, id string) (*pb.Ticket, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.AcknowledgeAssignment")
+	defer span.End()
+	return is.s.AcknowledgeAssignment(ctx, id)
+}
+
+func (is *instrumentedService) RequeueTickets(ctx context.Context, ids []string) (*pb.RequeueTicketsResponse, []*pb.Ticket, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.RequeueTickets")
+	defer span.End()
+	return is.s.RequeueTickets(ctx, ids)
+}
+
 func (is *instrumentedService) AddTicketsToPendingRelease(ctx context.Context, ids []string) error {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.AddTicketsToPendingRelease")
 	defer span.End()
@@ -101,12 +119,38 @@ func (is *instrumentedService) DeleteTicketsFromPendingRelease(ctx context.Conte
 	return is.s.DeleteTicketsFromPendingRelease(ctx, ids)
 }
 
+func (is *instrumentedService) CleanupPendingRelease(ctx context.Context) ([]string, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.CleanupPendingRelease")
+	defer span.End()
+	return is.s.CleanupPendingRelease(ctx)
+}
+
+func (is *instrumentedService) CleanupExpiredTickets(ctx context.Context) ([]string, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.CleanupExpiredTickets")
+	defer span.End()
+	return is.s.CleanupExpiredTickets(ctx)
+}
+
 func (is *instrumentedService) ReleaseAllTickets(ctx context.Context) error {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.ReleaseAllTickets")
 	defer span.End()
 	return is.s.ReleaseAllTickets(ctx)
 }
 
+// PublishMatch broadcasts the match to all current WatchMatches callers.
+func (is *instrumentedService) PublishMatch(ctx context.Context, match *pb.Match) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.PublishMatch")
+	defer span.End()
+	return is.s.PublishMatch(ctx, match)
+}
+
+// WatchMatches calls the callback for every match published from now on, until the context is canceled, the subscription fails or the callback returns an error.
+func (is *instrumentedService) WatchMatches(ctx context.Context, callback func(*pb.Match) error) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.WatchMatches")
+	defer span.End()
+	return is.s.WatchMatches(ctx, callback)
+}
+
 // CreateBackfill creates a new Backfill in the state storage if one doesn't exist. The xids algorithm used to create the ids ensures that they are unique with no system wide synchronization. Calling clients are forbidden from choosing an id during create. So no conflicts will occur.
 func (is *instrumentedService) CreateBackfill(ctx context.Context, backfill *pb.Backfill, ticketIDs []string) error {
 	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.CreateBackfill")
@@ -197,3 +241,60 @@ func (is *instrumentedService) DeleteBackfillCompletely(ctx context.Context, id
 	defer span.End()
 	return is.s.DeleteBackfillCompletely(ctx, id)
 }
+
+// AcknowledgeBackfill updates the acknowledgment time of the Backfill, then assigns its
+// tickets, deindexes them and removes them from the Backfill.
+func (is *instrumentedService) AcknowledgeBackfill(ctx context.Context, id string, assignment *pb.Assignment) (*pb.Backfill, []*pb.Ticket, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.AcknowledgeBackfill")
+	defer span.End()
+	return is.s.AcknowledgeBackfill(ctx, id, assignment)
+}
+
+// ExportTickets calls callback for every Ticket in the state storage, with its state.
+func (is *instrumentedService) ExportTickets(ctx context.Context, callback func(*pb.TicketRecord) error) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.ExportTickets")
+	defer span.End()
+	return is.s.ExportTickets(ctx, callback)
+}
+
+// ExportBackfills calls callback for every Backfill in the state storage, with its state.
+func (is *instrumentedService) ExportBackfills(ctx context.Context, callback func(*pb.BackfillRecord) error) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.ExportBackfills")
+	defer span.End()
+	return is.s.ExportBackfills(ctx, callback)
+}
+
+// ImportTicket writes an exported Ticket with its state.
+func (is *instrumentedService) ImportTicket(ctx context.Context, record *pb.TicketRecord, overwrite bool) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.ImportTicket")
+	defer span.End()
+	return is.s.ImportTicket(ctx, record, overwrite)
+}
+
+// ImportBackfill writes an exported Backfill with its state.
+func (is *instrumentedService) ImportBackfill(ctx context.Context, record *pb.BackfillRecord, overwrite bool) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.ImportBackfill")
+	defer span.End()
+	return is.s.ImportBackfill(ctx, record, overwrite)
+}
+
+// GetTicketRecord gets the Ticket with the specified id with its state.
+func (is *instrumentedService) GetTicketRecord(ctx context.Context, id string) (*pb.TicketRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.GetTicketRecord")
+	defer span.End()
+	return is.s.GetTicketRecord(ctx, id)
+}
+
+// ListPendingTickets returns the Tickets pending release.
+func (is *instrumentedService) ListPendingTickets(ctx context.Context) ([]*pb.PendingTicket, error) {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.ListPendingTickets")
+	defer span.End()
+	return is.s.ListPendingTickets(ctx)
+}
+
+// ExpireBackfill expires the Backfill as if it had not been acknowledged.
+func (is *instrumentedService) ExpireBackfill(ctx context.Context, id string) error {
+	ctx, span := trace.StartSpan(ctx, "statestore/instrumented.ExpireBackfill")
+	defer span.End()
+	return is.s.ExpireBackfill(ctx, id)
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	matchesChannel = "matches"
+)
+
+// PublishMatch broadcasts the match to all current WatchMatches callers.
+func (rb *redisBackend) PublishMatch(ctx context.Context, match *pb.Match) error {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "PublishMatch, id: %s, failed to connect to redis: %v", match.GetMatchId(), err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	value, err := proto.Marshal(match)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to marshal the match proto, id: %s", match.GetMatchId())
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	_, err = redisConn.Do("PUBLISH", matchesChannel, value)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to publish the match, id: %s", match.GetMatchId())
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return nil
+}
+
+// WatchMatches calls the callback for every match published from now on, until
+// the context is canceled, the subscription fails or the callback returns an error.
+func (rb *redisBackend) WatchMatches(ctx context.Context, callback func(*pb.Match) error) error {
+	// Watches last long: they get their own connection rather than holding
+	// one of the pool, and the subscribed connection is closed afterwards.
+	redisConn, err := rb.redisPool.DialContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "WatchMatches, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	psc := redis.PubSubConn{Conn: redisConn}
+	err = psc.Subscribe(matchesChannel)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "WatchMatches, failed to subscribe to matches: %v", err)
+	}
+
+	// Unsubscribing makes the blocking receive below return once the context is done.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			psc.Unsubscribe()
+		case <-done:
+		}
+	}()
+
+	for {
+		switch v := psc.ReceiveWithTimeout(0).(type) {
+		case redis.Message:
+			match := &pb.Match{}
+			err = proto.Unmarshal(v.Data, match)
+			if err != nil {
+				err = errors.Wrap(err, "failed to unmarshal the published match")
+				return status.Errorf(codes.Internal, "%v", err)
+			}
+
+			err = callback(match)
+			if err != nil {
+				return err
+			}
+		case redis.Subscription:
+			if v.Count == 0 {
+				return status.Errorf(codes.Canceled, "WatchMatches, %v", ctx.Err())
+			}
+		case error:
+			if ctx.Err() != nil {
+				return status.Errorf(codes.Canceled, "WatchMatches, %v", ctx.Err())
+			}
+			return status.Errorf(codes.Unavailable, "WatchMatches, lost the subscription to matches: %v", v)
+		}
+	}
+}
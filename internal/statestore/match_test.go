@@ -0,0 +1,120 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/config"
+	utilTesting "open-match.dev/open-match/internal/util/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestWatchMatches(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+
+	ctx, cancel := context.WithCancel(utilTesting.NewContext(t))
+	defer cancel()
+
+	received := make(chan *pb.Match, 1)
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- service.WatchMatches(ctx, func(m *pb.Match) error {
+			received <- m
+			return nil
+		})
+	}()
+
+	waitForMatchesSubscriber(t, cfg)
+
+	match := &pb.Match{
+		MatchId:       "1",
+		MatchProfile:  "profile",
+		MatchFunction: "function",
+		Tickets:       []*pb.Ticket{{Id: "t1"}},
+	}
+	require.NoError(t, service.PublishMatch(ctx, match))
+	require.True(t, proto.Equal(match, <-received))
+
+	cancel()
+	err := <-watchErr
+	require.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func TestWatchMatchesCallbackError(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	callbackErr := errors.New("callback failed")
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- service.WatchMatches(ctx, func(m *pb.Match) error {
+			return callbackErr
+		})
+	}()
+
+	waitForMatchesSubscriber(t, cfg)
+	require.NoError(t, service.PublishMatch(ctx, &pb.Match{MatchId: "1"}))
+	select {
+	case err := <-watchErr:
+		require.Equal(t, callbackErr, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "WatchMatches did not return the callback error")
+	}
+
+	// Pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	err := service.PublishMatch(ctx, &pb.Match{MatchId: "1"})
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "PublishMatch, id: 1, failed to connect to redis:")
+
+	err = service.WatchMatches(ctx, func(m *pb.Match) error { return nil })
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+}
+
+// waitForMatchesSubscriber waits until WatchMatches subscribed to the matches.
+func waitForMatchesSubscriber(t *testing.T, cfg config.View) {
+	c, err := redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.GetString("redis.hostname"), cfg.GetString("redis.port")))
+	require.NoError(t, err)
+	defer c.Close()
+	require.Eventually(t, func() bool {
+		subscribers, err := redis.Values(c.Do("PUBSUB", "NUMSUB", "matches"))
+		require.NoError(t, err)
+		n, err := redis.Int(subscribers[1], nil)
+		require.NoError(t, err)
+		return n == 1
+	}, time.Second, 10*time.Millisecond)
+}
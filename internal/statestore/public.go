@@ -17,6 +17,7 @@ package statestore
 import (
 	"context"
 
+	"open-match.dev/open-match/internal/clock"
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/telemetry"
 	"open-match.dev/open-match/pkg/pb"
@@ -43,14 +44,18 @@ type Service interface {
 	// This method succeeds if the Ticket does not exist.
 	DeleteTicket(ctx context.Context, id string) error
 
+	// UpdateTicket overwrites an indexed Ticket and updates its generation in the index.
+	// This method fails if the Ticket does not exist, is not indexed or is pending release.
+	UpdateTicket(ctx context.Context, ticket *pb.Ticket) error
+
 	// IndexTicket adds the ticket to the index.
 	IndexTicket(ctx context.Context, ticket *pb.Ticket) error
 
 	// DeindexTicket removes specified ticket from the index. The Ticket continues to exist.
 	DeindexTicket(ctx context.Context, id string) error
 
-	// GetIndexedIDSet returns the ids of all tickets currently indexed.
-	GetIndexedIDSet(ctx context.Context) (map[string]struct{}, error)
+	// GetIndexedIDSet returns the ids of all tickets currently indexed, mapped to their generation.
+	GetIndexedIDSet(ctx context.Context) (map[string]int, error)
 
 	// GetTickets returns multiple tickets from storage.
 	// Missing tickets are silently ignored.
@@ -62,15 +67,40 @@ type Service interface {
 	// GetAssignments returns the assignment associated with the input ticket id.
 	GetAssignments(ctx context.Context, id string, callback func(*pb.Assignment) error) error
 
+	// AcknowledgeAssignment records the acknowledgment time of the assignment of the specified ticket
+	// and resets its expiration. This method fails if the Ticket does not exist or is not assigned.
+	AcknowledgeAssignment(ctx context.Context, id string) (*pb.Ticket, error)
+
+	// RequeueTickets clears the assignments of the specified tickets, records them in the tickets'
+	// assignment history and adds the tickets back to the index.
+	RequeueTickets(ctx context.Context, ids []string) (*pb.RequeueTicketsResponse, []*pb.Ticket, error)
+
 	// AddTicketsToPendingRelease appends new proposed tickets to the proposed sorted set with current timestamp.
 	AddTicketsToPendingRelease(ctx context.Context, ids []string) error
 
 	// DeleteTicketsFromPendingRelease deletes tickets from the proposed sorted set.
 	DeleteTicketsFromPendingRelease(ctx context.Context, ids []string) error
 
+	// CleanupPendingRelease removes the tickets whose pending release timed out from the proposed
+	// sorted set and returns their ids. Concurrent callers never receive the same id.
+	CleanupPendingRelease(ctx context.Context) ([]string, error)
+
+	// CleanupExpiredTickets returns the ids of the tickets removed when their time to live
+	// elapsed, such as the assigned tickets. Concurrent callers never receive the same id.
+	CleanupExpiredTickets(ctx context.Context) ([]string, error)
+
 	// ReleaseAllTickets releases all pending tickets back to active.
 	ReleaseAllTickets(ctx context.Context) error
 
+	// Match
+
+	// PublishMatch broadcasts the match to all current WatchMatches callers.
+	PublishMatch(ctx context.Context, match *pb.Match) error
+
+	// WatchMatches calls the callback for every match published from now on, until the
+	// context is canceled, the subscription fails or the callback returns an error.
+	WatchMatches(ctx context.Context, callback func(*pb.Match) error) error
+
 	// Backfill
 
 	// CreateBackfill creates a new Backfill in the state storage if one doesn't exist.
@@ -93,6 +123,11 @@ type Service interface {
 	// DeleteBackfillCompletely performs a set of operations to remove backfill and all related entities.
 	DeleteBackfillCompletely(ctx context.Context, id string) error
 
+	// AcknowledgeBackfill updates the acknowledgment time of the Backfill, then assigns its
+	// tickets, deindexes them and removes them from the Backfill. Returns the Backfill and
+	// the assigned tickets.
+	AcknowledgeBackfill(ctx context.Context, id string, assignment *pb.Assignment) (*pb.Backfill, []*pb.Ticket, error)
+
 	// UpdateBackfill updates an existing Backfill with a new data. ticketIDs can be nil.
 	UpdateBackfill(ctx context.Context, backfill *pb.Backfill, ticketIDs []string) error
 
@@ -117,11 +152,43 @@ type Service interface {
 	// GetIndexedBackfills returns a map containing the IDs and
 	// the Generation number of the backfills currently indexed.
 	GetIndexedBackfills(ctx context.Context) (map[string]int, error)
+
+	// State
+
+	// ExportTickets calls callback for every Ticket in the state storage, with its state.
+	ExportTickets(ctx context.Context, callback func(*pb.TicketRecord) error) error
+
+	// ExportBackfills calls callback for every Backfill in the state storage, with its state.
+	ExportBackfills(ctx context.Context, callback func(*pb.BackfillRecord) error) error
+
+	// ImportTicket writes an exported Ticket with its state. Unless overwrite is set,
+	// it fails with AlreadyExists if the Ticket exists.
+	ImportTicket(ctx context.Context, record *pb.TicketRecord, overwrite bool) error
+
+	// ImportBackfill writes an exported Backfill with its state. Unless overwrite is set,
+	// it fails with AlreadyExists if the Backfill exists.
+	ImportBackfill(ctx context.Context, record *pb.BackfillRecord, overwrite bool) error
+
+	// GetTicketRecord gets the Ticket with the specified id with its state.
+	GetTicketRecord(ctx context.Context, id string) (*pb.TicketRecord, error)
+
+	// ListPendingTickets returns the Tickets pending release, with the time they are released.
+	ListPendingTickets(ctx context.Context) ([]*pb.PendingTicket, error)
+
+	// ExpireBackfill expires the Backfill as if it had not been acknowledged. It is
+	// deleted with its tickets released by the next CleanupBackfills.
+	ExpireBackfill(ctx context.Context, id string) error
 }
 
 // New creates a Service based on the configuration.
 func New(cfg config.View) Service {
-	s := newRedis(cfg)
+	return NewWithClock(cfg, clock.Real)
+}
+
+// NewWithClock creates a Service based on the configuration, which uses c to
+// time the pending releases and the backfill expirations.
+func NewWithClock(cfg config.View, c clock.Clock) Service {
+	s := newRedis(cfg, c)
 	if cfg.GetBool(telemetry.ConfigNameEnableMetrics) {
 		return &instrumentedService{
 			s: s,
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"sync/atomic"
 	"time"
 
 	rs "github.com/go-redsync/redsync/v4"
@@ -26,6 +27,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/clock"
 	"open-match.dev/open-match/internal/config"
 )
 
@@ -59,23 +61,83 @@ type redisBackend struct {
 	healthCheckPool *redis.Pool
 	redisPool       *redis.Pool
 	cfg             config.View
+	clock           clock.Clock
 	mutex           *rs.Mutex
+	unsubscribe     func()
+	// backoff of the active configuration, set by applyBackoff.
+	backoff atomic.Value // backoffConfig
+}
+
+// backoffConfig is the configuration of the retries of the state storage.
+type backoffConfig struct {
+	initialInterval time.Duration
+	randFactor      float64
+	multiplier      float64
+	maxInterval     time.Duration
+	maxElapsedTime  time.Duration
 }
 
 // Close the connection to the database.
 func (rb *redisBackend) Close() error {
+	rb.unsubscribe()
 	return rb.redisPool.Close()
 }
 
 // newRedis creates a statestore.Service backed by Redis database.
-func newRedis(cfg config.View) Service {
+func newRedis(cfg config.View, c clock.Clock) Service {
 	pool := GetRedisPool(cfg)
 	redsync = rs.New(rsredigo.NewPool(pool))
-	return &redisBackend{
+
+	rb := &redisBackend{
 		healthCheckPool: getHealthCheckPool(cfg),
 		redisPool:       pool,
 		cfg:             cfg,
+		clock:           c,
+	}
+	rb.backoff.Store(readBackoff(cfg))
+
+	unsubscribe, err := config.Subscribe(cfg, parseBackoff, rb.applyBackoff)
+	if err != nil {
+		redisLogger.WithError(err).Error("invalid backoff configuration")
+		unsubscribe = func() {}
+	}
+	rb.unsubscribe = unsubscribe
+	return rb
+}
+
+func readBackoff(cfg config.View) backoffConfig {
+	return backoffConfig{
+		initialInterval: cfg.GetDuration("backoff.initialInterval"),
+		randFactor:      cfg.GetFloat64("backoff.randFactor"),
+		multiplier:      cfg.GetFloat64("backoff.multiplier"),
+		maxInterval:     cfg.GetDuration("backoff.maxInterval"),
+		maxElapsedTime:  cfg.GetDuration("backoff.maxElapsedTime"),
+	}
+}
+
+// applyBackoff makes the next retries use the backoff returned by
+// parseBackoff.
+func (rb *redisBackend) applyBackoff(v interface{}) {
+	rb.backoff.Store(v.(backoffConfig))
+}
+
+// parseBackoff returns the backoff of the configuration, and rejects backoff
+// configurations which would retry in a busy loop or never back off.
+func parseBackoff(cfg config.View) (interface{}, error) {
+	initialInterval := cfg.GetDuration("backoff.initialInterval")
+	if cfg.IsSet("backoff.initialInterval") && initialInterval <= 0 {
+		return nil, fmt.Errorf("backoff.initialInterval must be positive, got %v", initialInterval)
+	}
+	if maxInterval := cfg.GetDuration("backoff.maxInterval"); cfg.IsSet("backoff.maxInterval") && maxInterval < initialInterval {
+		return nil, fmt.Errorf("backoff.maxInterval %v must not be less than backoff.initialInterval %v", maxInterval, initialInterval)
+	}
+	if multiplier := cfg.GetFloat64("backoff.multiplier"); cfg.IsSet("backoff.multiplier") && multiplier < 1 {
+		return nil, fmt.Errorf("backoff.multiplier must be at least 1, got %v", multiplier)
+	}
+	if randFactor := cfg.GetFloat64("backoff.randFactor"); randFactor < 0 || randFactor > 1 {
+		return nil, fmt.Errorf("backoff.randFactor must be between 0 and 1, got %v", randFactor)
 	}
+	return readBackoff(cfg), nil
 }
 
 func getHealthCheckPool(cfg config.View) *redis.Pool {
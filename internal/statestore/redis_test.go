@@ -2,7 +2,9 @@ package statestore
 
 import (
 	"testing"
+	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	utilTesting "open-match.dev/open-match/internal/util/testing"
 	"open-match.dev/open-match/pkg/pb"
@@ -31,3 +33,23 @@ func TestNewMutex(t *testing.T) {
 	require.True(t, b)
 
 }
+
+func TestParseBackoff(t *testing.T) {
+	cfg := viper.New()
+	cfg.Set("backoff.initialInterval", "100ms")
+	cfg.Set("backoff.maxInterval", "1s")
+	cfg.Set("backoff.multiplier", 1.5)
+	cfg.Set("backoff.randFactor", 0.5)
+	v, err := parseBackoff(cfg)
+	require.NoError(t, err)
+	require.Equal(t, 100*time.Millisecond, v.(backoffConfig).initialInterval)
+
+	cfg.Set("backoff.maxInterval", "10ms")
+	_, err = parseBackoff(cfg)
+	require.Error(t, err)
+
+	cfg.Set("backoff.maxInterval", "1s")
+	cfg.Set("backoff.multiplier", 0.5)
+	_, err = parseBackoff(cfg)
+	require.Error(t, err)
+}
@@ -0,0 +1,508 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/ipb"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// exportBatchSize is the number of entities read per redis call of an export.
+const exportBatchSize = 1000
+
+// expireBackfillScript resets the acknowledgment time of a backfill to 0 and
+// returns 1, or returns 0 if the backfill has none. Every Backfill has an
+// acknowledgment time, unlike the other keys. The check and the write are
+// atomic, so a backfill deleted concurrently is not added back.
+//
+//	KEYS: backfill acknowledgment times
+//	ARGV: backfill id
+var expireBackfillScript = redis.NewScript(1, `
+if not redis.call('ZSCORE', KEYS[1], ARGV[1]) then
+	return 0
+end
+redis.call('ZADD', KEYS[1], 0, ARGV[1])
+return 1
+`)
+
+// ExportTickets calls callback for every Ticket in the state storage, with
+// its index, pending release and expiration state. Tickets are found in the
+// index, the pending release and the expirations of the assigned Tickets, so
+// Tickets created or deleted during the export may be missed.
+func (rb *redisBackend) ExportTickets(ctx context.Context, callback func(*pb.TicketRecord) error) error {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "ExportTickets, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	pending, err := zsetScores(redisConn, proposedTicketIDs)
+	if err != nil {
+		return err
+	}
+	expirations, err := zsetScores(redisConn, ticketExpirations)
+	if err != nil {
+		return err
+	}
+	index, err := redis.StringMap(redisConn.Do("HGETALL", allTickets))
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to get the ticket index"))
+	}
+
+	ids := make([]interface{}, 0, len(index)+len(pending)+len(expirations))
+	seen := make(map[string]struct{}, cap(ids))
+	add := func(id string) {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	for id := range index {
+		add(id)
+	}
+	for id := range pending {
+		add(id)
+	}
+	for id := range expirations {
+		add(id)
+	}
+
+	for start := 0; start < len(ids); start += exportBatchSize {
+		end := start + exportBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		// Tickets may be deleted by the time they are read.
+		records, err := readTicketRecords(redisConn, ids[start:end], rb.clock.Now())
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			id := r.GetTicket().GetId()
+			_, r.Indexed = index[id]
+			if score, ok := pending[id]; ok {
+				if r.PendingReleaseTime, err = nanosToTimestamp(score); err != nil {
+					return err
+				}
+			}
+			if err := callback(r); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readTicketRecords reads the tickets of ids with their expiration time.
+// Keys which no longer exist are skipped.
+func readTicketRecords(conn redis.Conn, ids []interface{}, now time.Time) ([]*pb.TicketRecord, error) {
+	values, err := redis.ByteSlices(conn.Do("MGET", ids...))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to read the tickets"))
+	}
+
+	for _, id := range ids {
+		if err := conn.Send("PTTL", id); err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to send PTTL command"))
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to read the ticket expirations"))
+	}
+	ttls := make([]int64, len(ids))
+	for i := range ids {
+		if ttls[i], err = redis.Int64(conn.Receive()); err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to read the ticket expirations"))
+		}
+	}
+
+	records := make([]*pb.TicketRecord, 0, len(ids))
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+		t := &pb.Ticket{}
+		if err := proto.Unmarshal(value, t); err != nil {
+			err = errors.Wrapf(err, "failed to unmarshal ticket from redis, key %s", ids[i])
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+
+		r := &pb.TicketRecord{Ticket: t}
+		if ttls[i] > 0 {
+			if r.ExpireTime, err = ptypes.TimestampProto(now.Add(time.Duration(ttls[i]) * time.Millisecond)); err != nil {
+				return nil, status.Errorf(codes.Internal, "%v", err)
+			}
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// ExportBackfills calls callback for every Backfill in the state storage,
+// with its associated tickets, index and acknowledgment state.
+func (rb *redisBackend) ExportBackfills(ctx context.Context, callback func(*pb.BackfillRecord) error) error {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "ExportBackfills, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	acks, err := zsetScores(redisConn, backfillLastAckTime)
+	if err != nil {
+		return err
+	}
+	index, err := redis.StringMap(redisConn.Do("HGETALL", allBackfills))
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to get the backfill index"))
+	}
+
+	ttl := getBackfillReleaseTimeout(rb.cfg)
+	ids := make([]interface{}, 0, len(acks))
+	for id := range acks {
+		ids = append(ids, id)
+	}
+
+	for start := 0; start < len(ids); start += exportBatchSize {
+		end := start + exportBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		values, err := redis.ByteSlices(redisConn.Do("MGET", ids[start:end]...))
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to read the backfills"))
+		}
+
+		for i, value := range values {
+			// Backfills may be deleted by the time they are read.
+			if value == nil {
+				continue
+			}
+			id := ids[start+i].(string)
+			bi := &ipb.BackfillInternal{}
+			if err := proto.Unmarshal(value, bi); err != nil {
+				err = errors.Wrapf(err, "failed to unmarshal internal backfill, id: %s", id)
+				return status.Errorf(codes.Internal, "%v", err)
+			}
+
+			r := &pb.BackfillRecord{
+				Backfill:  bi.Backfill,
+				TicketIds: bi.TicketIds,
+			}
+			_, r.Indexed = index[id]
+			if r.LastAcknowledgedTime, err = nanosToTimestamp(acks[id]); err != nil {
+				return err
+			}
+			if r.ExpireTime, err = nanosToTimestamp(acks[id] + ttl.Nanoseconds()); err != nil {
+				return err
+			}
+			if err := callback(r); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetTicketRecord returns the Ticket with the specified id with its state.
+func (rb *redisBackend) GetTicketRecord(ctx context.Context, id string) (*pb.TicketRecord, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "GetTicketRecord, id: %s, failed to connect to redis: %v", id, err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	now := rb.clock.Now()
+	records, err := readTicketRecords(redisConn, []interface{}{id}, now)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
+	}
+	r := records[0]
+
+	if r.Indexed, err = redis.Bool(redisConn.Do("HEXISTS", allTickets, id)); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrapf(err, "failed to get the index of ticket, id: %s", id))
+	}
+
+	score, err := redis.Float64(redisConn.Do("ZSCORE", proposedTicketIDs, id))
+	if err != nil && err != redis.ErrNil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrapf(err, "failed to get the pending release of ticket, id: %s", id))
+	}
+	// Tickets are released once pendingReleaseTimeout elapsed, even before
+	// they are removed from the pending release.
+	if err == nil && int64(score) > now.Add(-rb.cfg.GetDuration("pendingReleaseTimeout")).UnixNano() {
+		if r.PendingReleaseTime, err = nanosToTimestamp(int64(score)); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// ListPendingTickets returns the Tickets pending release, the first released
+// first.
+func (rb *redisBackend) ListPendingTickets(ctx context.Context) ([]*pb.PendingTicket, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "ListPendingTickets, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	ttl := rb.cfg.GetDuration("pendingReleaseTimeout")
+	curTime := rb.clock.Now()
+	endTimeInt := curTime.Add(time.Hour).UnixNano()
+	startTimeInt := curTime.Add(-ttl).UnixNano()
+
+	values, err := redis.Strings(redisConn.Do("ZRANGEBYSCORE", proposedTicketIDs, startTimeInt, endTimeInt, "WITHSCORES"))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting pending release %v", err)
+	}
+
+	tickets := make([]*pb.PendingTicket, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		score, err := strconv.ParseFloat(values[i+1], 64)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrapf(err, "invalid pending release time of ticket, id: %s", values[i]))
+		}
+		t := &pb.PendingTicket{TicketId: values[i]}
+		if t.PendingReleaseTime, err = nanosToTimestamp(int64(score)); err != nil {
+			return nil, err
+		}
+		if t.ReleaseTime, err = nanosToTimestamp(int64(score) + ttl.Nanoseconds()); err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, t)
+	}
+	return tickets, nil
+}
+
+// ExpireBackfill expires the Backfill with the specified id, as if it had
+// not been acknowledged since pendingReleaseTimeout: it is no longer returned
+// by queries nor can be acknowledged, and it is deleted with its tickets
+// released by the next CleanupBackfills.
+func (rb *redisBackend) ExpireBackfill(ctx context.Context, id string) error {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "ExpireBackfill, id: %s, failed to connect to redis: %v", id, err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	found, err := redis.Bool(expireBackfillScript.Do(redisConn, backfillLastAckTime, id))
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrapf(err, "failed to expire backfill, id: %s", id))
+	}
+	if !found {
+		return status.Errorf(codes.NotFound, "Backfill id: %s not found", id)
+	}
+	return nil
+}
+
+// ImportTicket writes the Ticket of record with its state. Unless overwrite
+// is set, it fails with AlreadyExists if the Ticket exists. It fails with
+// FailedPrecondition if the Ticket has expired.
+func (rb *redisBackend) ImportTicket(ctx context.Context, record *pb.TicketRecord, overwrite bool) error {
+	t := record.GetTicket()
+	if t.GetId() == "" {
+		return status.Error(codes.InvalidArgument, ".ticket.id is required")
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "ImportTicket, id: %s, failed to connect to redis: %v", t.GetId(), err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	value, err := proto.Marshal(t)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to marshal the ticket proto, id: %s", t.GetId())
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	args := []interface{}{t.GetId(), value}
+	var ttl time.Duration
+	if record.GetExpireTime() != nil {
+		expireTime, err := ptypes.Timestamp(record.GetExpireTime())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, ".expire_time is invalid: %v", err)
+		}
+		ttl = expireTime.Sub(rb.clock.Now())
+		if ttl < time.Millisecond {
+			return status.Errorf(codes.FailedPrecondition, "ticket expired, id: %s", t.GetId())
+		}
+		args = append(args, "PX", int64(ttl/time.Millisecond))
+	}
+
+	commands := []redisCommand{{"SET", args}}
+	if record.GetIndexed() {
+		commands = append(commands, redisCommand{"HSET", []interface{}{allTickets, t.GetId(), t.GetGeneration()}})
+	} else {
+		commands = append(commands, redisCommand{"HDEL", []interface{}{allTickets, t.GetId()}})
+	}
+	if ttl > 0 {
+		commands = append(commands, redisCommand{"ZADD", []interface{}{ticketExpirations, rb.clock.Now().Add(ttl).UnixNano(), t.GetId()}})
+	} else {
+		commands = append(commands, redisCommand{"ZREM", []interface{}{ticketExpirations, t.GetId()}})
+	}
+	if record.GetPendingReleaseTime() != nil {
+		pendingTime, err := ptypes.Timestamp(record.GetPendingReleaseTime())
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, ".pending_release_time is invalid: %v", err)
+		}
+		commands = append(commands, redisCommand{"ZADD", []interface{}{proposedTicketIDs, pendingTime.UnixNano(), t.GetId()}})
+	} else {
+		commands = append(commands, redisCommand{"ZREM", []interface{}{proposedTicketIDs, t.GetId()}})
+	}
+
+	return importEntity(redisConn, "ticket", t.GetId(), overwrite, commands)
+}
+
+// ImportBackfill writes the Backfill of record with its associated tickets
+// and state. Unless overwrite is set, it fails with AlreadyExists if the
+// Backfill exists. Backfills without acknowledgment time are acknowledged
+// now.
+func (rb *redisBackend) ImportBackfill(ctx context.Context, record *pb.BackfillRecord, overwrite bool) error {
+	b := record.GetBackfill()
+	if b.GetId() == "" {
+		return status.Error(codes.InvalidArgument, ".backfill.id is required")
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "ImportBackfill, id: %s, failed to connect to redis: %v", b.GetId(), err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	lastAck := rb.clock.Now()
+	if record.GetLastAcknowledgedTime() != nil {
+		if lastAck, err = ptypes.Timestamp(record.GetLastAcknowledgedTime()); err != nil {
+			return status.Errorf(codes.InvalidArgument, ".last_acknowledged_time is invalid: %v", err)
+		}
+	}
+
+	value, err := proto.Marshal(&ipb.BackfillInternal{
+		Backfill:  b,
+		TicketIds: record.GetTicketIds(),
+	})
+	if err != nil {
+		err = errors.Wrapf(err, "failed to marshal the backfill proto, id: %s", b.GetId())
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	commands := []redisCommand{{"SET", []interface{}{b.GetId(), value}}}
+	if record.GetIndexed() {
+		commands = append(commands, redisCommand{"HSET", []interface{}{allBackfills, b.GetId(), b.GetGeneration()}})
+	} else {
+		commands = append(commands, redisCommand{"HDEL", []interface{}{allBackfills, b.GetId()}})
+	}
+	commands = append(commands, redisCommand{"ZADD", []interface{}{backfillLastAckTime, lastAck.UnixNano(), b.GetId()}})
+
+	return importEntity(redisConn, "backfill", b.GetId(), overwrite, commands)
+}
+
+type redisCommand struct {
+	name string
+	args []interface{}
+}
+
+// importEntity runs the commands writing an entity and its state in a
+// transaction, so that it is imported as a whole or not at all. Unless
+// overwrite is set, it returns AlreadyExists if the entity exists, or is
+// created before the transaction.
+func importEntity(conn redis.Conn, kind, id string, overwrite bool, commands []redisCommand) error {
+	if !overwrite {
+		if _, err := conn.Do("WATCH", id); err != nil {
+			err = errors.Wrapf(err, "failed to watch the %s, id: %s", kind, id)
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+		exists, err := redis.Bool(conn.Do("EXISTS", id))
+		if err != nil {
+			conn.Do("UNWATCH")
+			err = errors.Wrapf(err, "failed to check whether the %s exists, id: %s", kind, id)
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+		if exists {
+			conn.Do("UNWATCH")
+			return status.Errorf(codes.AlreadyExists, "%s already exists, id: %s", kind, id)
+		}
+	}
+
+	if err := conn.Send("MULTI"); err != nil {
+		conn.Do("UNWATCH")
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error starting redis multi"))
+	}
+	for _, c := range commands {
+		if err := conn.Send(c.name, c.args...); err != nil {
+			conn.Do("DISCARD")
+			err = errors.Wrapf(err, "error sending %s for %s, id: %s", c.name, kind, id)
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+	}
+
+	replies, err := redis.Values(conn.Do("EXEC"))
+	if err == redis.ErrNil {
+		return status.Errorf(codes.AlreadyExists, "%s already exists, id: %s", kind, id)
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "failed to import the %s, id: %s", kind, id)
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+	if len(replies) != len(commands) {
+		return status.Errorf(codes.Internal, "sent %d commands for %s %s to redis, but received %d replies back", len(commands), kind, id, len(replies))
+	}
+	for i, reply := range replies {
+		if err, ok := reply.(redis.Error); ok {
+			err := errors.Wrapf(err, "failed to import the %s, id: %s, %s failed", kind, id, commands[i].name)
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+	}
+	return nil
+}
+
+// zsetScores returns the members of the sorted set key with their score.
+func zsetScores(conn redis.Conn, key string) (map[string]int64, error) {
+	values, err := redis.StringMap(conn.Do("ZRANGE", key, 0, -1, "WITHSCORES"))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrapf(err, "failed to read %s", key))
+	}
+	scores := make(map[string]int64, len(values))
+	for member, score := range values {
+		// Scores are nanosecond timestamps, which redis may format as floats.
+		f, err := strconv.ParseFloat(score, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrapf(err, "invalid score of %s in %s", member, key))
+		}
+		scores[member] = int64(f)
+	}
+	return scores, nil
+}
+
+func nanosToTimestamp(nanos int64) (*timestamp.Timestamp, error) {
+	ts, err := ptypes.TimestampProto(time.Unix(0, nanos))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return ts, nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statestore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/clock"
+	utilTesting "open-match.dev/open-match/internal/util/testing"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestExportImportRoundTrip(t *testing.T) {
+	require := require.New(t)
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	source := New(cfg)
+	defer source.Close()
+	ctx := utilTesting.NewContext(t)
+
+	tickets, _ := generateTickets(ctx, t, source, 3)
+	require.NoError(source.AddTicketsToPendingRelease(ctx, []string{tickets[1].GetId()}))
+	_, _, err := source.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{
+			TicketIds:  []string{tickets[2].GetId()},
+			Assignment: &pb.Assignment{Connection: "127.0.0.1:7777"},
+		}},
+	})
+	require.NoError(err)
+	require.NoError(source.DeindexTicket(ctx, tickets[2].GetId()))
+
+	backfill := &pb.Backfill{Id: "backfill-1", Generation: 1}
+	require.NoError(source.CreateBackfill(ctx, backfill, []string{tickets[1].GetId()}))
+	require.NoError(source.IndexBackfill(ctx, backfill))
+
+	// Keys which are not tickets are not exported.
+	conn := GetRedisPool(cfg).Get()
+	_, err = conn.Do("SET", "unrelated", "value")
+	require.NoError(err)
+	require.NoError(conn.Close())
+
+	var ticketRecords []*pb.TicketRecord
+	require.NoError(source.ExportTickets(ctx, func(r *pb.TicketRecord) error {
+		ticketRecords = append(ticketRecords, r)
+		return nil
+	}))
+	var backfillRecords []*pb.BackfillRecord
+	require.NoError(source.ExportBackfills(ctx, func(r *pb.BackfillRecord) error {
+		backfillRecords = append(backfillRecords, r)
+		return nil
+	}))
+
+	require.Len(ticketRecords, 3)
+	byID := make(map[string]*pb.TicketRecord)
+	for _, r := range ticketRecords {
+		byID[r.GetTicket().GetId()] = r
+	}
+	require.True(byID[tickets[0].GetId()].GetIndexed())
+	require.Nil(byID[tickets[0].GetId()].GetPendingReleaseTime())
+	require.NotNil(byID[tickets[1].GetId()].GetPendingReleaseTime())
+	require.False(byID[tickets[2].GetId()].GetIndexed())
+	require.Equal("127.0.0.1:7777", byID[tickets[2].GetId()].GetTicket().GetAssignment().GetConnection())
+	require.NotNil(byID[tickets[2].GetId()].GetExpireTime())
+
+	require.Len(backfillRecords, 1)
+	require.Equal(backfill.GetId(), backfillRecords[0].GetBackfill().GetId())
+	require.Equal([]string{tickets[1].GetId()}, backfillRecords[0].GetTicketIds())
+	require.True(backfillRecords[0].GetIndexed())
+	require.NotNil(backfillRecords[0].GetLastAcknowledgedTime())
+
+	targetCfg, targetCloser := createRedis(t, false, "")
+	defer targetCloser()
+	target := New(targetCfg)
+	defer target.Close()
+
+	for _, r := range ticketRecords {
+		require.NoError(target.ImportTicket(ctx, r, false))
+	}
+	for _, r := range backfillRecords {
+		require.NoError(target.ImportBackfill(ctx, r, false))
+	}
+
+	indexed, err := target.GetIndexedIDSet(ctx)
+	require.NoError(err)
+	require.Len(indexed, 1)
+	require.Contains(indexed, tickets[0].GetId())
+
+	assigned, err := target.GetTicket(ctx, tickets[2].GetId())
+	require.NoError(err)
+	require.Equal("127.0.0.1:7777", assigned.GetAssignment().GetConnection())
+
+	b, ticketIDs, err := target.GetBackfill(ctx, backfill.GetId())
+	require.NoError(err)
+	require.Equal(backfill.GetGeneration(), b.GetGeneration())
+	require.Equal([]string{tickets[1].GetId()}, ticketIDs)
+
+	indexedBackfills, err := target.GetIndexedBackfills(ctx)
+	require.NoError(err)
+	require.Contains(indexedBackfills, backfill.GetId())
+}
+
+func TestImportConflicts(t *testing.T) {
+	require := require.New(t)
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	tickets, _ := generateTickets(ctx, t, service, 1)
+	record := &pb.TicketRecord{Ticket: tickets[0], Indexed: true}
+
+	err := service.ImportTicket(ctx, record, false)
+	require.Equal(codes.AlreadyExists, status.Code(err))
+	require.NoError(service.ImportTicket(ctx, record, true))
+
+	expired, err := ptypes.TimestampProto(time.Now().Add(-time.Minute))
+	require.NoError(err)
+	err = service.ImportTicket(ctx, &pb.TicketRecord{Ticket: &pb.Ticket{Id: "expired"}, ExpireTime: expired}, false)
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+
+	err = service.ImportTicket(ctx, &pb.TicketRecord{Ticket: &pb.Ticket{}}, false)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	backfill := &pb.BackfillRecord{Backfill: &pb.Backfill{Id: "backfill-1"}}
+	require.NoError(service.ImportBackfill(ctx, backfill, false))
+	err = service.ImportBackfill(ctx, backfill, false)
+	require.Equal(codes.AlreadyExists, status.Code(err))
+	require.NoError(service.ImportBackfill(ctx, backfill, true))
+
+	// A Backfill imported without acknowledgment time is acknowledged on import.
+	require.NoError(service.ExportBackfills(ctx, func(r *pb.BackfillRecord) error {
+		require.NotNil(r.GetLastAcknowledgedTime())
+		return nil
+	}))
+}
+
+func TestExportConnectionError(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	defer service.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := service.ExportTickets(ctx, func(*pb.TicketRecord) error { return nil })
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	err = service.ExportBackfills(ctx, func(*pb.BackfillRecord) error { return nil })
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestGetTicketRecord(t *testing.T) {
+	require := require.New(t)
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	tickets, _ := generateTickets(ctx, t, service, 1)
+	id := tickets[0].GetId()
+
+	r, err := service.GetTicketRecord(ctx, id)
+	require.NoError(err)
+	require.Equal(id, r.GetTicket().GetId())
+	require.True(r.GetIndexed())
+	require.Nil(r.GetPendingReleaseTime())
+
+	require.NoError(service.AddTicketsToPendingRelease(ctx, []string{id}))
+	r, err = service.GetTicketRecord(ctx, id)
+	require.NoError(err)
+	require.NotNil(r.GetPendingReleaseTime())
+
+	_, err = service.GetTicketRecord(ctx, "unknown")
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestListPendingTickets(t *testing.T) {
+	require := require.New(t)
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	fake := clock.NewFake(time.Now())
+	service := NewWithClock(cfg, fake)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	tickets, _ := generateTickets(ctx, t, service, 3)
+	require.NoError(service.AddTicketsToPendingRelease(ctx, []string{tickets[0].GetId()}))
+	fake.Advance(100 * time.Millisecond)
+	require.NoError(service.AddTicketsToPendingRelease(ctx, []string{tickets[1].GetId()}))
+
+	pending, err := service.ListPendingTickets(ctx)
+	require.NoError(err)
+	require.Len(pending, 2)
+	require.Equal(tickets[0].GetId(), pending[0].GetTicketId())
+	require.Equal(tickets[1].GetId(), pending[1].GetTicketId())
+
+	pendingTime, err := ptypes.Timestamp(pending[1].GetPendingReleaseTime())
+	require.NoError(err)
+	releaseTime, err := ptypes.Timestamp(pending[1].GetReleaseTime())
+	require.NoError(err)
+	require.Equal(cfg.GetDuration("pendingReleaseTimeout"), releaseTime.Sub(pendingTime))
+
+	// Tickets whose pending release timed out are released.
+	fake.Advance(cfg.GetDuration("pendingReleaseTimeout"))
+	pending, err = service.ListPendingTickets(ctx)
+	require.NoError(err)
+	require.Len(pending, 1)
+	require.Equal(tickets[1].GetId(), pending[0].GetTicketId())
+}
+
+func TestExpireBackfill(t *testing.T) {
+	require := require.New(t)
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	backfill := &pb.Backfill{Id: "backfill-1", Generation: 1}
+	require.NoError(service.CreateBackfill(ctx, backfill, nil))
+	require.NoError(service.IndexBackfill(ctx, backfill))
+
+	require.NoError(service.ExpireBackfill(ctx, backfill.GetId()))
+	expired, err := service.GetExpiredBackfillIDs(ctx)
+	require.NoError(err)
+	require.Equal([]string{backfill.GetId()}, expired)
+	indexed, err := service.GetIndexedBackfills(ctx)
+	require.NoError(err)
+	require.Empty(indexed)
+
+	// Expiring it again is not an error.
+	require.NoError(service.ExpireBackfill(ctx, backfill.GetId()))
+
+	err = service.ExpireBackfill(ctx, "unknown")
+	require.Equal(codes.NotFound, status.Code(err))
+}
@@ -17,10 +17,12 @@ package statestore
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/cenkalti/backoff"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/gomodule/redigo/redis"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
@@ -29,10 +31,59 @@ import (
 )
 
 const (
-	allTickets        = "allTickets"
+	// allTickets maps the indexed ticket ids to their generation.
+	allTickets        = "allTicketGenerations"
 	proposedTicketIDs = "proposed_ticket_ids"
+
+	// legacyAllTickets is the set of indexed ticket ids written by the
+	// releases before tickets had generations.
+	legacyAllTickets = "allTickets"
+
+	// ticketExpirations scores the ids of the tickets with a time to live by
+	// their expiration time, so that the tickets removed by redis when it
+	// elapses can be reported.
+	ticketExpirations = "ticketExpirations"
 )
 
+// updateTicketScript overwrites an indexed ticket and its generation in the
+// index, unless it is missing (its index entry is then dropped), assigned (assigned tickets expire), deindexed
+// or pending release. The checks and the write are atomic, so the update can't
+// overwrite an assignment made or a proposal recorded concurrently.
+//
+//	KEYS: ticket id, index, pending release set
+//	ARGV: ticket value, generation, oldest pending release time
+var updateTicketScript = redis.NewScript(3, `
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl == -2 then
+	redis.call('HDEL', KEYS[2], KEYS[1])
+	return 'NOT_FOUND'
+end
+if ttl >= 0 or redis.call('HEXISTS', KEYS[2], KEYS[1]) == 0 then
+	return 'NOT_INDEXED'
+end
+local proposed = redis.call('ZSCORE', KEYS[3], KEYS[1])
+if proposed and tonumber(proposed) >= tonumber(ARGV[3]) then
+	return 'PENDING_RELEASE'
+end
+redis.call('SET', KEYS[1], ARGV[1])
+redis.call('HSET', KEYS[2], KEYS[1], ARGV[2])
+return 'OK'
+`)
+
+// cleanupPendingReleaseScript removes the tickets whose pending release timed
+// out from the pending release set and returns their ids. The range and the
+// removal are atomic, so a ticket proposed again meanwhile is not removed.
+//
+//	KEYS: pending release set
+//	ARGV: pending release time before which the pending release timed out
+var cleanupPendingReleaseScript = redis.NewScript(1, `
+local ids = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', '(' .. ARGV[1])
+if #ids > 0 then
+	redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', '(' .. ARGV[1])
+end
+return ids
+`)
+
 // CreateTicket creates a new Ticket in the state storage. If the id already exists, it will be overwritten.
 func (rb *redisBackend) CreateTicket(ctx context.Context, ticket *pb.Ticket) error {
 	redisConn, err := rb.redisPool.GetContext(ctx)
@@ -99,19 +150,69 @@ func (rb *redisBackend) DeleteTicket(ctx context.Context, id string) error {
 	}
 	defer handleConnectionClose(&redisConn)
 
-	value, err := redis.Int(redisConn.Do("DEL", id))
+	err = redisConn.Send("MULTI")
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", errors.Wrap(err, "error starting redis multi"))
+	}
+	err = redisConn.Send("DEL", id)
+	if err != nil {
+		redisConn.Do("DISCARD")
+		return status.Errorf(codes.Internal, "%v", errors.Wrapf(err, "failed to delete the ticket from state storage, id: %s", id))
+	}
+	err = redisConn.Send("ZREM", ticketExpirations, id)
+	if err != nil {
+		redisConn.Do("DISCARD")
+		return status.Errorf(codes.Internal, "%v", errors.Wrapf(err, "failed to remove the expiration of ticket, id: %s", id))
+	}
+	replies, err := redis.Ints(redisConn.Do("EXEC"))
 	if err != nil {
 		err = errors.Wrapf(err, "failed to delete the ticket from state storage, id: %s", id)
 		return status.Errorf(codes.Internal, "%v", err)
 	}
 
-	if value == 0 {
+	if replies[0] == 0 {
 		return status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
 	}
 
 	return nil
 }
 
+// UpdateTicket overwrites an existing indexed Ticket in the state storage and
+// updates its generation in the index. This method fails if the Ticket does not
+// exist, is not indexed or is pending release.
+func (rb *redisBackend) UpdateTicket(ctx context.Context, ticket *pb.Ticket) error {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "UpdateTicket, id: %s, failed to connect to redis: %v", ticket.GetId(), err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	value, err := proto.Marshal(ticket)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to marshal the ticket proto, id: %s", ticket.GetId())
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	pendingSince := rb.clock.Now().Add(-rb.cfg.GetDuration("pendingReleaseTimeout")).UnixNano()
+	result, err := redis.String(updateTicketScript.Do(redisConn, ticket.GetId(), allTickets, proposedTicketIDs, value, ticket.GetGeneration(), pendingSince))
+	if err != nil {
+		err = errors.Wrapf(err, "failed to update the ticket, id: %s", ticket.GetId())
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	switch result {
+	case "OK":
+		return nil
+	case "NOT_FOUND":
+		return status.Errorf(codes.NotFound, "Ticket id: %s not found", ticket.GetId())
+	case "NOT_INDEXED":
+		return status.Errorf(codes.FailedPrecondition, "can not update a ticket which is not indexed, id: %s", ticket.GetId())
+	case "PENDING_RELEASE":
+		return status.Errorf(codes.FailedPrecondition, "can not update a ticket which is pending release, id: %s", ticket.GetId())
+	}
+	return status.Errorf(codes.Internal, "unexpected response from redis: %s", result)
+}
+
 // IndexTicket indexes the Ticket id for the configured index fields.
 func (rb *redisBackend) IndexTicket(ctx context.Context, ticket *pb.Ticket) error {
 	redisConn, err := rb.redisPool.GetContext(ctx)
@@ -120,7 +221,7 @@ func (rb *redisBackend) IndexTicket(ctx context.Context, ticket *pb.Ticket) erro
 	}
 	defer handleConnectionClose(&redisConn)
 
-	err = redisConn.Send("SADD", allTickets, ticket.Id)
+	err = redisConn.Send("HSET", allTickets, ticket.Id, ticket.Generation)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to add ticket to all tickets, id: %s", ticket.Id)
 		return status.Errorf(codes.Internal, "%v", err)
@@ -137,7 +238,7 @@ func (rb *redisBackend) DeindexTicket(ctx context.Context, id string) error {
 	}
 	defer handleConnectionClose(&redisConn)
 
-	err = redisConn.Send("SREM", allTickets, id)
+	err = redisConn.Send("HDEL", allTickets, id)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to remove ticket from all tickets, id: %s", id)
 		return status.Errorf(codes.Internal, "%v", err)
@@ -146,8 +247,9 @@ func (rb *redisBackend) DeindexTicket(ctx context.Context, id string) error {
 	return nil
 }
 
-// GetIndexedIds returns the ids of all tickets currently indexed.
-func (rb *redisBackend) GetIndexedIDSet(ctx context.Context) (map[string]struct{}, error) {
+// GetIndexedIDSet returns the ids of all tickets currently indexed, mapped to
+// the generation they were indexed with.
+func (rb *redisBackend) GetIndexedIDSet(ctx context.Context) (map[string]int, error) {
 	redisConn, err := rb.redisPool.GetContext(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Unavailable, "GetIndexedIDSet, failed to connect to redis: %v", err)
@@ -155,7 +257,7 @@ func (rb *redisBackend) GetIndexedIDSet(ctx context.Context) (map[string]struct{
 	defer handleConnectionClose(&redisConn)
 
 	ttl := rb.cfg.GetDuration("pendingReleaseTimeout")
-	curTime := time.Now()
+	curTime := rb.clock.Now()
 	endTimeInt := curTime.Add(time.Hour).UnixNano()
 	startTimeInt := curTime.Add(-ttl).UnixNano()
 
@@ -165,14 +267,23 @@ func (rb *redisBackend) GetIndexedIDSet(ctx context.Context) (map[string]struct{
 		return nil, status.Errorf(codes.Internal, "error getting pending release %v", err)
 	}
 
-	idsIndexed, err := redis.Strings(redisConn.Do("SMEMBERS", allTickets))
+	err = migrateLegacyTicketIndex(redisConn)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error migrating the legacy ticket index %v", err)
+	}
+
+	index, err := redis.StringMap(redisConn.Do("HGETALL", allTickets))
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error getting all indexed ticket ids %v", err)
 	}
 
-	r := make(map[string]struct{}, len(idsIndexed))
-	for _, id := range idsIndexed {
-		r[id] = struct{}{}
+	r := make(map[string]int, len(index))
+	for id, generation := range index {
+		gen, err := strconv.Atoi(generation)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error while parsing generation into number: %v", err)
+		}
+		r[id] = gen
 	}
 	for _, id := range idsInPendingReleases {
 		delete(r, id)
@@ -181,6 +292,59 @@ func (rb *redisBackend) GetIndexedIDSet(ctx context.Context) (map[string]struct{
 	return r, nil
 }
 
+// migrateLegacyTicketIndex moves the tickets of the legacy index set into the
+// index, with generation 0. It runs on every read of the index so that tickets
+// indexed by the previous release during a rolling upgrade are migrated too.
+func migrateLegacyTicketIndex(conn redis.Conn) error {
+	kind, err := redis.String(conn.Do("TYPE", legacyAllTickets))
+	if err != nil {
+		return errors.Wrap(err, "failed to get the type of the legacy index")
+	}
+	if kind != "set" {
+		return nil
+	}
+
+	_, err = conn.Do("WATCH", legacyAllTickets)
+	if err != nil {
+		return errors.Wrap(err, "failed to watch the legacy index")
+	}
+	ids, err := redis.Strings(conn.Do("SMEMBERS", legacyAllTickets))
+	if err != nil {
+		conn.Do("UNWATCH")
+		return errors.Wrap(err, "failed to get the legacy indexed ticket ids")
+	}
+
+	err = conn.Send("MULTI")
+	if err != nil {
+		conn.Do("UNWATCH")
+		return errors.Wrap(err, "error starting redis multi")
+	}
+	for _, id := range ids {
+		// Tickets indexed since by the current release keep their generation.
+		err = conn.Send("HSETNX", allTickets, id, 0)
+		if err != nil {
+			conn.Do("DISCARD")
+			return errors.Wrap(err, "error sending legacy ticket index migration")
+		}
+	}
+	err = conn.Send("DEL", legacyAllTickets)
+	if err != nil {
+		conn.Do("DISCARD")
+		return errors.Wrap(err, "error sending legacy ticket index deletion")
+	}
+
+	// A nil reply means the legacy index changed concurrently, it is migrated
+	// on the next read.
+	reply, err := conn.Do("EXEC")
+	if err != nil {
+		return errors.Wrap(err, "failed to migrate the legacy ticket index")
+	}
+	if reply != nil {
+		redisLogger.Infof("migrated %d tickets from the legacy ticket index", len(ids))
+	}
+	return nil
+}
+
 // GetTickets returns multiple tickets from storage.  Missing tickets are
 // silently ignored.
 func (rb *redisBackend) GetTickets(ctx context.Context, ids []string) ([]*pb.Ticket, error) {
@@ -297,25 +461,32 @@ func (rb *redisBackend) UpdateAssignments(ctx context.Context, req *pb.AssignTic
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "error sending ticket assignment set")
 		}
+		err = rb.sendTicketExpiration(redisConn, ticket.Id, assignmentTimeout*time.Millisecond)
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
-	wasSet, err := redis.Values(redisConn.Do("EXEC"))
+	replies, err := redis.Values(redisConn.Do("EXEC"))
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "error executing assignment set")
 	}
 
-	if len(wasSet) != len(tickets) {
-		return nil, nil, status.Errorf(codes.Internal, "sent %d tickets to redis, but received %d back", len(tickets), len(wasSet))
+	// Each SET is followed by the ZADD of the ticket expiration.
+	if len(replies) != 2*len(tickets) {
+		return nil, nil, status.Errorf(codes.Internal, "sent %d tickets to redis, but received %d replies back", len(tickets), len(replies))
 	}
 
 	assignedTickets := make([]*pb.Ticket, 0, len(tickets))
+	var missing []interface{}
 	for i, ticket := range tickets {
-		v, err := redis.String(wasSet[i], nil)
+		v, err := redis.String(replies[2*i], nil)
 		if err == redis.ErrNil {
 			resp.Failures = append(resp.Failures, &pb.AssignmentFailure{
 				TicketId: ticket.Id,
 				Cause:    pb.AssignmentFailure_TICKET_NOT_FOUND,
 			})
+			missing = append(missing, ticket.Id)
 			continue
 		}
 		if err != nil {
@@ -327,9 +498,27 @@ func (rb *redisBackend) UpdateAssignments(ctx context.Context, req *pb.AssignTic
 		assignedTickets = append(assignedTickets, ticket)
 	}
 
+	if len(missing) > 0 {
+		// The tickets deleted concurrently don't expire.
+		_, err = redisConn.Do("ZREM", append([]interface{}{ticketExpirations}, missing...)...)
+		if err != nil {
+			logger.WithError(err).Warning("failed to remove the expiration of the missing tickets")
+		}
+	}
+
 	return resp, assignedTickets, nil
 }
 
+// sendTicketExpiration queues the update of the expiration of the ticket id,
+// which expires after ttl.
+func (rb *redisBackend) sendTicketExpiration(redisConn redis.Conn, id string, ttl time.Duration) error {
+	err := redisConn.Send("ZADD", ticketExpirations, rb.clock.Now().Add(ttl).UnixNano(), id)
+	if err != nil {
+		return errors.Wrapf(err, "error sending the expiration of ticket, id: %s", id)
+	}
+	return nil
+}
+
 // GetAssignments returns the assignment associated with the input ticket id
 func (rb *redisBackend) GetAssignments(ctx context.Context, id string, callback func(*pb.Assignment) error) error {
 	redisConn, err := rb.redisPool.GetContext(ctx)
@@ -360,6 +549,288 @@ func (rb *redisBackend) GetAssignments(ctx context.Context, id string, callback
 	return nil
 }
 
+// AcknowledgeAssignment records the acknowledgment time of the assignment of the specified ticket
+// and resets its expiration to assignedDeleteTimeout.
+func (rb *redisBackend) AcknowledgeAssignment(ctx context.Context, id string) (*pb.Ticket, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "AcknowledgeAssignment, id: %s, failed to connect to redis: %v", id, err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	// The ticket is watched so that the acknowledgment can't restore an
+	// assignment cleared concurrently by RequeueTickets.
+	for attempt := 0; attempt < maxAcknowledgeAttempts; attempt++ {
+		ticket, acknowledged, err := rb.acknowledgeAssignment(redisConn, id)
+		if err != nil {
+			return nil, err
+		}
+		if acknowledged {
+			return ticket, nil
+		}
+	}
+	return nil, status.Errorf(codes.Aborted, "the ticket was modified concurrently, id: %s", id)
+}
+
+// maxAcknowledgeAttempts bounds the retries of AcknowledgeAssignment when the
+// ticket is modified concurrently.
+const maxAcknowledgeAttempts = 3
+
+// acknowledgeAssignment returns false if the ticket was modified between its
+// read and the write of the acknowledgment.
+func (rb *redisBackend) acknowledgeAssignment(redisConn redis.Conn, id string) (*pb.Ticket, bool, error) {
+	_, err := redisConn.Do("WATCH", id)
+	if err != nil {
+		err = errors.Wrapf(err, "failed to watch the ticket, id: %s", id)
+		return nil, false, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	value, err := redis.Bytes(redisConn.Do("GET", id))
+	if err != nil {
+		redisConn.Do("UNWATCH")
+		if err == redis.ErrNil {
+			return nil, false, status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
+		}
+		err = errors.Wrapf(err, "failed to get the ticket from state storage, id: %s", id)
+		return nil, false, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	ticket := &pb.Ticket{}
+	err = proto.Unmarshal(value, ticket)
+	if err != nil {
+		redisConn.Do("UNWATCH")
+		err = errors.Wrapf(err, "failed to unmarshal the ticket proto, id: %s", id)
+		return nil, false, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	if ticket.Assignment == nil {
+		redisConn.Do("UNWATCH")
+		return nil, false, status.Errorf(codes.FailedPrecondition, "can not acknowledge the assignment of a ticket which is not assigned, id: %s", id)
+	}
+	ticket.AssignmentAcknowledgeTime = ptypes.TimestampNow()
+
+	value, err = proto.Marshal(ticket)
+	if err != nil {
+		redisConn.Do("UNWATCH")
+		err = errors.Wrapf(err, "failed to marshal the ticket proto, id: %s", id)
+		return nil, false, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	err = redisConn.Send("MULTI")
+	if err != nil {
+		redisConn.Do("UNWATCH")
+		return nil, false, errors.Wrap(err, "error starting redis multi")
+	}
+	assignmentTimeout := rb.cfg.GetDuration("assignedDeleteTimeout") / time.Millisecond
+	err = redisConn.Send("SET", id, value, "PX", int64(assignmentTimeout), "XX")
+	if err != nil {
+		redisConn.Do("DISCARD")
+		return nil, false, errors.Wrap(err, "error sending ticket acknowledgment set")
+	}
+	err = rb.sendTicketExpiration(redisConn, id, assignmentTimeout*time.Millisecond)
+	if err != nil {
+		redisConn.Do("DISCARD")
+		return nil, false, err
+	}
+
+	replies, err := redis.Values(redisConn.Do("EXEC"))
+	if err == redis.ErrNil {
+		return nil, false, nil
+	}
+	if err != nil {
+		err = errors.Wrapf(err, "failed to acknowledge the ticket assignment, id: %s", id)
+		return nil, false, status.Errorf(codes.Internal, "%v", err)
+	}
+	if len(replies) == 0 || replies[0] == nil {
+		return nil, false, status.Errorf(codes.NotFound, "Ticket id: %s not found", id)
+	}
+
+	return ticket, true, nil
+}
+
+// RequeueTickets clears the assignments of the specified tickets, records them in the tickets'
+// assignment history, removes their expiration and adds the tickets back to the index.
+func (rb *redisBackend) RequeueTickets(ctx context.Context, ids []string) (*pb.RequeueTicketsResponse, []*pb.Ticket, error) {
+	if len(ids) == 0 {
+		return &pb.RequeueTicketsResponse{}, []*pb.Ticket{}, nil
+	}
+
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Unavailable, "RequeueTickets, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	idsI := make([]interface{}, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "Ticket id %s is requeued multiple times in one requeue tickets call", id)
+		}
+		seen[id] = struct{}{}
+		idsI = append(idsI, id)
+	}
+
+	// The tickets are watched so that the requeue can't overwrite an
+	// acknowledgment or an update written concurrently.
+	for attempt := 0; attempt < maxRequeueAttempts; attempt++ {
+		resp, tickets, requeued, err := rb.requeueTickets(redisConn, ids, idsI)
+		if err != nil {
+			return nil, nil, err
+		}
+		if requeued {
+			return resp, tickets, nil
+		}
+	}
+	return nil, nil, status.Errorf(codes.Aborted, "the tickets were modified concurrently")
+}
+
+// maxRequeueAttempts bounds the retries of RequeueTickets when the tickets are
+// modified concurrently.
+const maxRequeueAttempts = 3
+
+// requeueTickets returns false if a ticket was modified between the read of
+// the tickets and the write of their requeue.
+func (rb *redisBackend) requeueTickets(redisConn redis.Conn, ids []string, idsI []interface{}) (*pb.RequeueTicketsResponse, []*pb.Ticket, bool, error) {
+	_, err := redisConn.Do("WATCH", idsI...)
+	if err != nil {
+		err = errors.Wrap(err, "failed to watch the requeued tickets")
+		return nil, nil, false, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	ticketBytes, err := redis.ByteSlices(redisConn.Do("MGET", idsI...))
+	if err != nil {
+		redisConn.Do("UNWATCH")
+		return nil, nil, false, err
+	}
+
+	requeueTime, err := ptypes.TimestampProto(rb.clock.Now())
+	if err != nil {
+		redisConn.Do("UNWATCH")
+		return nil, nil, false, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp := &pb.RequeueTicketsResponse{}
+	tickets := make([]*pb.Ticket, 0, len(ticketBytes))
+	for i, ticketByte := range ticketBytes {
+		// Tickets may be deleted by the time we read it from redis.
+		if ticketByte == nil {
+			resp.Failures = append(resp.Failures, &pb.AssignmentFailure{
+				TicketId: ids[i],
+				Cause:    pb.AssignmentFailure_TICKET_NOT_FOUND,
+			})
+			continue
+		}
+
+		t := &pb.Ticket{}
+		err = proto.Unmarshal(ticketByte, t)
+		if err != nil {
+			redisConn.Do("UNWATCH")
+			err = errors.Wrapf(err, "failed to unmarshal ticket from redis %s", ids[i])
+			return nil, nil, false, status.Errorf(codes.Internal, "%v", err)
+		}
+
+		if t.Assignment == nil {
+			resp.Failures = append(resp.Failures, &pb.AssignmentFailure{
+				TicketId: ids[i],
+				Cause:    pb.AssignmentFailure_TICKET_NOT_ASSIGNED,
+			})
+			continue
+		}
+
+		t.AssignmentHistory = append(t.AssignmentHistory, &pb.AssignmentRecord{
+			Assignment:      t.Assignment,
+			AcknowledgeTime: t.AssignmentAcknowledgeTime,
+			RequeueTime:     requeueTime,
+		})
+		t.Assignment = nil
+		t.AssignmentAcknowledgeTime = nil
+		tickets = append(tickets, t)
+	}
+
+	if len(tickets) == 0 {
+		redisConn.Do("UNWATCH")
+		return resp, []*pb.Ticket{}, true, nil
+	}
+
+	err = redisConn.Send("MULTI")
+	if err != nil {
+		redisConn.Do("UNWATCH")
+		return nil, nil, false, errors.Wrap(err, "error starting redis multi")
+	}
+
+	for _, ticket := range tickets {
+		var ticketByte []byte
+		ticketByte, err = proto.Marshal(ticket)
+		if err != nil {
+			redisConn.Do("DISCARD")
+			return nil, nil, false, status.Errorf(codes.Internal, "failed to marshal ticket %s", ticket.GetId())
+		}
+
+		// SET without expiration clears the assignedDeleteTimeout of the ticket.
+		err = redisConn.Send("SET", ticket.Id, ticketByte, "XX")
+		if err != nil {
+			redisConn.Do("DISCARD")
+			return nil, nil, false, errors.Wrap(err, "error sending ticket requeue set")
+		}
+		err = redisConn.Send("ZREM", ticketExpirations, ticket.Id)
+		if err != nil {
+			redisConn.Do("DISCARD")
+			return nil, nil, false, errors.Wrap(err, "error sending ticket expiration removal")
+		}
+	}
+
+	replies, err := redis.Values(redisConn.Do("EXEC"))
+	if err == redis.ErrNil {
+		return nil, nil, false, nil
+	}
+	if err != nil {
+		return nil, nil, false, errors.Wrap(err, "error executing requeue set")
+	}
+
+	// Each SET is followed by the ZREM of the ticket expiration.
+	if len(replies) != 2*len(tickets) {
+		return nil, nil, false, status.Errorf(codes.Internal, "sent %d tickets to redis, but received %d replies back", len(tickets), len(replies))
+	}
+
+	requeuedTickets := make([]*pb.Ticket, 0, len(tickets))
+	for i, ticket := range tickets {
+		v, err := redis.String(replies[2*i], nil)
+		if err == redis.ErrNil {
+			resp.Failures = append(resp.Failures, &pb.AssignmentFailure{
+				TicketId: ticket.Id,
+				Cause:    pb.AssignmentFailure_TICKET_NOT_FOUND,
+			})
+			continue
+		}
+		if err != nil {
+			return nil, nil, false, errors.Wrap(err, "unexpected error from redis multi set")
+		}
+		if v != "OK" {
+			return nil, nil, false, status.Errorf(codes.Internal, "unexpected response from redis: %s", v)
+		}
+		requeuedTickets = append(requeuedTickets, ticket)
+	}
+
+	if len(requeuedTickets) == 0 {
+		return resp, requeuedTickets, true, nil
+	}
+
+	cmds := make([]interface{}, 0, 2*len(requeuedTickets)+1)
+	cmds = append(cmds, allTickets)
+	for _, ticket := range requeuedTickets {
+		cmds = append(cmds, ticket.Id, ticket.Generation)
+	}
+
+	_, err = redisConn.Do("HSET", cmds...)
+	if err != nil {
+		err = errors.Wrap(err, "failed to add requeued tickets to all tickets")
+		return nil, nil, false, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	return resp, requeuedTickets, true, nil
+}
+
 // AddTicketsToPendingRelease appends new proposed tickets to the proposed sorted set with current timestamp
 func (rb *redisBackend) AddTicketsToPendingRelease(ctx context.Context, ids []string) error {
 	if len(ids) == 0 {
@@ -372,7 +843,7 @@ func (rb *redisBackend) AddTicketsToPendingRelease(ctx context.Context, ids []st
 	}
 	defer handleConnectionClose(&redisConn)
 
-	currentTime := time.Now().UnixNano()
+	currentTime := rb.clock.Now().UnixNano()
 	cmds := make([]interface{}, 0, 2*len(ids)+1)
 	cmds = append(cmds, proposedTicketIDs)
 	for _, id := range ids {
@@ -415,6 +886,97 @@ func (rb *redisBackend) DeleteTicketsFromPendingRelease(ctx context.Context, ids
 	return nil
 }
 
+// CleanupPendingRelease removes the tickets whose pending release timed out from
+// the proposed sorted set, and returns their ids. Concurrent callers never
+// receive the same id.
+func (rb *redisBackend) CleanupPendingRelease(ctx context.Context) ([]string, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "CleanupPendingRelease, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	expiredBefore := rb.clock.Now().Add(-rb.cfg.GetDuration("pendingReleaseTimeout")).UnixNano()
+	ids, err := redis.Strings(cleanupPendingReleaseScript.Do(redisConn, proposedTicketIDs, expiredBefore))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to remove expired pending release"))
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return ids, nil
+}
+
+// CleanupExpiredTickets returns the ids of the tickets removed by redis when
+// their time to live elapsed, and forgets them. Concurrent callers never
+// receive the same id.
+func (rb *redisBackend) CleanupExpiredTickets(ctx context.Context) ([]string, error) {
+	redisConn, err := rb.redisPool.GetContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "CleanupExpiredTickets, failed to connect to redis: %v", err)
+	}
+	defer handleConnectionClose(&redisConn)
+
+	now := rb.clock.Now()
+	ids, err := redis.Strings(redisConn.Do("ZRANGEBYSCORE", ticketExpirations, "-inf", now.UnixNano()))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error getting the expired tickets %v", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	for _, id := range ids {
+		err = redisConn.Send("PTTL", id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to send PTTL command"))
+		}
+	}
+	err = redisConn.Flush()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to flush PTTL commands"))
+	}
+	ttls := make([]int64, len(ids))
+	for i := range ids {
+		ttls[i], err = redis.Int64(redisConn.Receive())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to get the time to live of the tickets"))
+		}
+	}
+
+	err = redisConn.Send("MULTI")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to send MULTI command"))
+	}
+	for i, id := range ids {
+		switch {
+		case ttls[i] >= 0:
+			// The ticket expires later than recorded, its time to live was
+			// extended by an update the expiration missed.
+			err = redisConn.Send("ZADD", ticketExpirations, "XX", now.Add(time.Duration(ttls[i])*time.Millisecond).UnixNano(), id)
+		default:
+			// -2 for the removed tickets, -1 for those which no longer expire.
+			err = redisConn.Send("ZREM", ticketExpirations, id)
+		}
+		if err != nil {
+			redisConn.Do("DISCARD")
+			return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to send the expiration update"))
+		}
+	}
+	replies, err := redis.Ints(redisConn.Do("EXEC"))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", errors.Wrap(err, "failed to update the ticket expirations"))
+	}
+
+	expired := make([]string, 0, len(ids))
+	for i, id := range ids {
+		if ttls[i] == -2 && replies[i] == 1 {
+			expired = append(expired, id)
+		}
+	}
+	return expired, nil
+}
+
 func (rb *redisBackend) ReleaseAllTickets(ctx context.Context) error {
 	redisConn, err := rb.redisPool.GetContext(ctx)
 	if err != nil {
@@ -427,18 +989,19 @@ func (rb *redisBackend) ReleaseAllTickets(ctx context.Context) error {
 }
 
 func (rb *redisBackend) newConstantBackoffStrategy() backoff.BackOff {
-	backoffStrat := backoff.NewConstantBackOff(rb.cfg.GetDuration("backoff.initialInterval"))
+	backoffStrat := backoff.NewConstantBackOff(rb.backoff.Load().(backoffConfig).initialInterval)
 	return backoff.BackOff(backoffStrat)
 }
 
 // TODO: add cache the backoff object
 // nolint: unused
 func (rb *redisBackend) newExponentialBackoffStrategy() backoff.BackOff {
+	cfg := rb.backoff.Load().(backoffConfig)
 	backoffStrat := backoff.NewExponentialBackOff()
-	backoffStrat.InitialInterval = rb.cfg.GetDuration("backoff.initialInterval")
-	backoffStrat.RandomizationFactor = rb.cfg.GetFloat64("backoff.randFactor")
-	backoffStrat.Multiplier = rb.cfg.GetFloat64("backoff.multiplier")
-	backoffStrat.MaxInterval = rb.cfg.GetDuration("backoff.maxInterval")
-	backoffStrat.MaxElapsedTime = rb.cfg.GetDuration("backoff.maxElapsedTime")
+	backoffStrat.InitialInterval = cfg.initialInterval
+	backoffStrat.RandomizationFactor = cfg.randFactor
+	backoffStrat.Multiplier = cfg.multiplier
+	backoffStrat.MaxInterval = cfg.maxInterval
+	backoffStrat.MaxElapsedTime = cfg.maxElapsedTime
 	return backoff.BackOff(backoffStrat)
 }
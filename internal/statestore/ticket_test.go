@@ -25,12 +25,15 @@ import (
 
 	"github.com/Bose/minisentinel"
 	miniredis "github.com/alicebob/miniredis/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/gomodule/redigo/redis"
 	"github.com/rs/xid"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/clock"
 	"open-match.dev/open-match/internal/config"
 	"open-match.dev/open-match/internal/telemetry"
 	utilTesting "open-match.dev/open-match/internal/util/testing"
@@ -351,6 +354,124 @@ func TestUpdateAssignments(t *testing.T) {
 	require.Contains(t, status.Convert(err).Message(), "UpdateAssignments, failed to connect to redis: context canceled")
 }
 
+func TestAcknowledgeAssignment(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	require.NoError(t, service.CreateTicket(ctx, &pb.Ticket{Id: "unassigned"}))
+	require.NoError(t, service.CreateTicket(ctx, &pb.Ticket{Id: "assigned"}))
+	_, _, err := service.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{"assigned"},
+				Assignment: &pb.Assignment{Connection: "2"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = service.AcknowledgeAssignment(ctx, "missing")
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+
+	_, err = service.AcknowledgeAssignment(ctx, "unassigned")
+	require.Equal(t, codes.FailedPrecondition.String(), status.Convert(err).Code().String())
+
+	ticket, err := service.AcknowledgeAssignment(ctx, "assigned")
+	require.NoError(t, err)
+	require.NotNil(t, ticket.AssignmentAcknowledgeTime)
+	require.Equal(t, "2", ticket.Assignment.Connection)
+
+	stored, err := service.GetTicket(ctx, "assigned")
+	require.NoError(t, err)
+	require.True(t, proto.Equal(ticket, stored))
+
+	c, err := redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.GetString("redis.hostname"), cfg.GetString("redis.port")))
+	require.NoError(t, err)
+	ttl, err := redis.Int64(c.Do("PTTL", "assigned"))
+	require.NoError(t, err)
+	require.True(t, ttl > 0)
+
+	// pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	_, err = service.AcknowledgeAssignment(ctx, "assigned")
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "AcknowledgeAssignment, id: assigned, failed to connect to redis:")
+}
+
+func TestRequeueTickets(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	fake := clock.NewFake(time.Now())
+	service := NewWithClock(cfg, fake)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	createTime := ptypes.TimestampNow()
+	require.NoError(t, service.CreateTicket(ctx, &pb.Ticket{Id: "unassigned", CreateTime: createTime, Generation: 1}))
+	require.NoError(t, service.CreateTicket(ctx, &pb.Ticket{Id: "assigned", CreateTime: createTime, Generation: 1}))
+	_, _, err := service.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{"assigned"},
+				Assignment: &pb.Assignment{Connection: "2"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = service.AcknowledgeAssignment(ctx, "assigned")
+	require.NoError(t, err)
+
+	_, _, err = service.RequeueTickets(ctx, []string{"assigned", "assigned"})
+	require.Equal(t, codes.InvalidArgument.String(), status.Convert(err).Code().String())
+
+	resp, tickets, err := service.RequeueTickets(ctx, []string{"assigned", "unassigned", "missing"})
+	require.NoError(t, err)
+	require.Len(t, tickets, 1)
+	require.ElementsMatch(t, []*pb.AssignmentFailure{
+		{TicketId: "unassigned", Cause: pb.AssignmentFailure_TICKET_NOT_ASSIGNED},
+		{TicketId: "missing", Cause: pb.AssignmentFailure_TICKET_NOT_FOUND},
+	}, resp.Failures)
+
+	stored, err := service.GetTicket(ctx, "assigned")
+	require.NoError(t, err)
+	require.Nil(t, stored.Assignment)
+	require.Nil(t, stored.AssignmentAcknowledgeTime)
+	require.True(t, proto.Equal(createTime, stored.CreateTime))
+	require.Len(t, stored.AssignmentHistory, 1)
+	require.Equal(t, "2", stored.AssignmentHistory[0].Assignment.Connection)
+	require.NotNil(t, stored.AssignmentHistory[0].AcknowledgeTime)
+	requeueTime, err := ptypes.Timestamp(stored.AssignmentHistory[0].RequeueTime)
+	require.NoError(t, err)
+	require.True(t, fake.Now().Equal(requeueTime))
+
+	ids, err := service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"assigned": 1}, ids)
+
+	c, err := redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.GetString("redis.hostname"), cfg.GetString("redis.port")))
+	require.NoError(t, err)
+	ttl, err := redis.Int64(c.Do("PTTL", "assigned"))
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), ttl)
+
+	// pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	_, _, err = service.RequeueTickets(ctx, []string{"assigned"})
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "RequeueTickets, failed to connect to redis:")
+}
+
 func TestConnect(t *testing.T) {
 	testConnect(t, false, "")
 	testConnect(t, false, "redispassword")
@@ -595,7 +716,7 @@ func TestIndexTicket(t *testing.T) {
 
 	c, err := redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.GetString("redis.hostname"), cfg.GetString("redis.port")))
 	require.NoError(t, err)
-	idsIndexed, err := redis.Strings(c.Do("SMEMBERS", allTickets))
+	idsIndexed, err := redis.Strings(c.Do("HKEYS", allTickets))
 	require.NoError(t, err)
 	require.Len(t, idsIndexed, 2)
 	require.Equal(t, "mockTicketID-0", idsIndexed[0])
@@ -627,7 +748,7 @@ func TestDeindexTicket(t *testing.T) {
 
 	c, err := redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.GetString("redis.hostname"), cfg.GetString("redis.port")))
 	require.NoError(t, err)
-	idsIndexed, err := redis.Strings(c.Do("SMEMBERS", "allTickets"))
+	idsIndexed, err := redis.Strings(c.Do("HKEYS", allTickets))
 	require.NoError(t, err)
 	require.Len(t, idsIndexed, 2)
 	require.Equal(t, "mockTicketID-0", idsIndexed[0])
@@ -636,7 +757,7 @@ func TestDeindexTicket(t *testing.T) {
 	// deindex and check that there is only 1 ticket in the returned slice
 	err = service.DeindexTicket(ctx, "mockTicketID-1")
 	require.NoError(t, err)
-	idsIndexed, err = redis.Strings(c.Do("SMEMBERS", "allTickets"))
+	idsIndexed, err = redis.Strings(c.Do("HKEYS", allTickets))
 	require.NoError(t, err)
 	require.Len(t, idsIndexed, 1)
 	require.Equal(t, "mockTicketID-0", idsIndexed[0])
@@ -651,6 +772,124 @@ func TestDeindexTicket(t *testing.T) {
 	require.Contains(t, status.Convert(err).Message(), "DeindexTicket, id: 12345, failed to connect to redis:")
 }
 
+func TestUpdateTicket(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+
+	ctx := utilTesting.NewContext(t)
+
+	ticket := &pb.Ticket{
+		Id:         "mockTicketID",
+		Generation: 1,
+		SearchFields: &pb.SearchFields{
+			Tags: []string{"mode.demo"},
+		},
+	}
+	require.NoError(t, service.CreateTicket(ctx, ticket))
+
+	// not indexed, err expected
+	err := service.UpdateTicket(ctx, ticket)
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition.String(), status.Convert(err).Code().String())
+
+	require.NoError(t, service.IndexTicket(ctx, ticket))
+
+	updated := &pb.Ticket{
+		Id:         "mockTicketID",
+		Generation: 2,
+		SearchFields: &pb.SearchFields{
+			Tags: []string{"mode.ranked"},
+		},
+	}
+	require.NoError(t, service.UpdateTicket(ctx, updated))
+
+	stored, err := service.GetTicket(ctx, "mockTicketID")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), stored.Generation)
+	require.Equal(t, []string{"mode.ranked"}, stored.SearchFields.Tags)
+
+	ids, err := service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, ids["mockTicketID"])
+
+	// pending release, err expected
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, []string{"mockTicketID"}))
+	err = service.UpdateTicket(ctx, updated)
+	require.Error(t, err)
+	require.Equal(t, codes.FailedPrecondition.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "pending release")
+
+	// pending release expired, update succeeds
+	time.Sleep(cfg.GetDuration("pendingReleaseTimeout"))
+	require.NoError(t, service.UpdateTicket(ctx, updated))
+
+	// assigned tickets are never overwritten, even before they are deindexed
+	_, _, err = service.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{
+			TicketIds:  []string{"mockTicketID"},
+			Assignment: &pb.Assignment{Connection: "127.0.0.1:7777"},
+		}},
+	})
+	require.NoError(t, err)
+	err = service.UpdateTicket(ctx, updated)
+	require.Equal(t, codes.FailedPrecondition.String(), status.Convert(err).Code().String())
+	stored, err = service.GetTicket(ctx, "mockTicketID")
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1:7777", stored.GetAssignment().GetConnection())
+
+	// ticket deleted while indexed, err expected
+	require.NoError(t, service.DeleteTicket(ctx, "mockTicketID"))
+	err = service.UpdateTicket(ctx, updated)
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound.String(), status.Convert(err).Code().String())
+	ids, err = service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 0)
+
+	// pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	err = service.UpdateTicket(ctx, updated)
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "UpdateTicket, id: mockTicketID, failed to connect to redis:")
+}
+
+func TestLegacyTicketIndexMigration(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	tickets, _ := generateTickets(ctx, t, service, 1)
+
+	c, err := redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.GetString("redis.hostname"), cfg.GetString("redis.port")))
+	require.NoError(t, err)
+	defer c.Close()
+	_, err = c.Do("SADD", legacyAllTickets, "legacyTicketID", tickets[0].GetId())
+	require.NoError(t, err)
+
+	ids, err := service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"legacyTicketID": 0, tickets[0].GetId(): int(tickets[0].GetGeneration())}, ids)
+
+	exists, err := redis.Bool(c.Do("EXISTS", legacyAllTickets))
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// The migrated tickets can be deindexed.
+	require.NoError(t, service.DeindexTicket(ctx, "legacyTicketID"))
+	ids, err = service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+}
+
 func TestGetIndexedIDSet(t *testing.T) {
 	cfg, closer := createRedis(t, false, "")
 	defer closer()
@@ -696,6 +935,29 @@ func TestGetIndexedIDSet(t *testing.T) {
 	require.Contains(t, status.Convert(err).Message(), "GetIndexedIDSet, failed to connect to redis:")
 }
 
+func TestGetIndexedIDSetWithFakeClock(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	fake := clock.NewFake(time.Now())
+	service := NewWithClock(cfg, fake)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	tickets, _ := generateTickets(ctx, t, service, 2)
+	require.NoError(t, service.AddTicketsToPendingRelease(ctx, []string{tickets[0].GetId()}))
+
+	ids, err := service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+
+	// The pending release expires on the fake clock, without waiting.
+	fake.Advance(cfg.GetDuration("pendingReleaseTimeout") + time.Millisecond)
+	ids, err = service.GetIndexedIDSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+}
+
 func TestGetTickets(t *testing.T) {
 	cfg, closer := createRedis(t, false, "")
 	defer closer()
@@ -827,6 +1089,109 @@ func TestReleaseAllTickets(t *testing.T) {
 	require.Contains(t, status.Convert(err).Message(), "ReleaseAllTickets, failed to connect to redis:")
 }
 
+func TestCleanupPendingRelease(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	service := New(cfg)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	_, ids := generateTickets(ctx, t, service, 2)
+
+	c, err := redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.GetString("redis.hostname"), cfg.GetString("redis.port")))
+	require.NoError(t, err)
+	// The first ticket's pending release timed out, the second one is still pending
+	expired := time.Now().Add(-2 * cfg.GetDuration("pendingReleaseTimeout")).UnixNano()
+	_, err = c.Do("ZADD", "proposed_ticket_ids", expired, ids[0], time.Now().UnixNano(), ids[1])
+	require.NoError(t, err)
+
+	expiredIDs, err := service.CleanupPendingRelease(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ids[:1], expiredIDs)
+
+	remaining, err := redis.Strings(c.Do("ZRANGE", "proposed_ticket_ids", 0, -1))
+	require.NoError(t, err)
+	require.Equal(t, ids[1:], remaining)
+
+	// Expired tickets are reported once
+	expiredIDs, err = service.CleanupPendingRelease(ctx)
+	require.NoError(t, err)
+	require.Empty(t, expiredIDs)
+
+	// Pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	_, err = service.CleanupPendingRelease(ctx)
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "CleanupPendingRelease, failed to connect to redis:")
+}
+
+func TestCleanupExpiredTickets(t *testing.T) {
+	cfg, closer := createRedis(t, false, "")
+	defer closer()
+	fake := clock.NewFake(time.Now())
+	service := NewWithClock(cfg, fake)
+	require.NotNil(t, service)
+	defer service.Close()
+	ctx := utilTesting.NewContext(t)
+
+	_, ids := generateTickets(ctx, t, service, 3)
+	_, _, err := service.UpdateAssignments(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{TicketIds: ids, Assignment: &pb.Assignment{Connection: "1.2.3.4:5678"}}},
+	})
+	require.NoError(t, err)
+	// Requeued tickets no longer expire.
+	_, _, err = service.RequeueTickets(ctx, ids[2:])
+	require.NoError(t, err)
+
+	c, err := redis.Dial("tcp", fmt.Sprintf("%s:%s", cfg.GetString("redis.hostname"), cfg.GetString("redis.port")))
+	require.NoError(t, err)
+	expirations, err := redis.Strings(c.Do("ZRANGE", ticketExpirations, 0, -1))
+	require.NoError(t, err)
+	require.ElementsMatch(t, ids[:2], expirations)
+
+	// Nothing expired yet.
+	expiredIDs, err := service.CleanupExpiredTickets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, expiredIDs)
+
+	// The time to live of the first ticket elapses, the second ticket still
+	// lives as miniredis doesn't follow the fake clock.
+	_, err = c.Do("DEL", ids[0])
+	require.NoError(t, err)
+	fake.Advance(cfg.GetDuration("assignedDeleteTimeout") + time.Millisecond)
+	expiredIDs, err = service.CleanupExpiredTickets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ids[:1], expiredIDs)
+
+	// Expired tickets are reported once, and the expiration of the living
+	// ticket was pushed back.
+	expiredIDs, err = service.CleanupExpiredTickets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, expiredIDs)
+	score, err := redis.Int64(c.Do("ZSCORE", ticketExpirations, ids[1]))
+	require.NoError(t, err)
+	require.Greater(t, score, fake.Now().UnixNano())
+
+	// Deleted tickets are forgotten.
+	require.NoError(t, service.DeleteTicket(ctx, ids[1]))
+	expirations, err = redis.Strings(c.Do("ZRANGE", ticketExpirations, 0, -1))
+	require.NoError(t, err)
+	require.Empty(t, expirations)
+
+	// Pass an expired context, err expected
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service = New(cfg)
+	_, err = service.CleanupExpiredTickets(ctx)
+	require.Error(t, err)
+	require.Equal(t, codes.Unavailable.String(), status.Convert(err).Code().String())
+	require.Contains(t, status.Convert(err).Message(), "CleanupExpiredTickets, failed to connect to redis:")
+}
+
 func TestAddTicketsToPendingRelease(t *testing.T) {
 	cfg, closer := createRedis(t, false, "")
 	defer closer()
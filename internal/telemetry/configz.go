@@ -23,7 +23,6 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/spf13/viper"
 	"open-match.dev/open-match/internal/config"
 )
 
@@ -35,9 +34,15 @@ const (
 	<title>Open Match Configuration</title>
 </head>
 <body>
+{{- with .Status }}
+<p>Version {{ .Version }} loaded at {{ .LoadedAt.Format "2006-01-02T15:04:05Z07:00" }} from {{ .File }}</p>
+{{- if .RejectedError }}
+<p>Last reload rejected at {{ .RejectedAt.Format "2006-01-02T15:04:05Z07:00" }}: {{ .RejectedError }}</p>
+{{- end }}
+{{- end }}
 <table>
 <tr><th>Key</th><th>Value</th></tr>
-{{ range $key, $value := . }}
+{{ range $key, $value := .Values }}
 <tr><td>{{ $value.Key }}</td><td>{{ $value.Value }}</td></tr>
 {{ end }}
 </table>
@@ -58,11 +63,20 @@ type configZValue struct {
 	Value interface{}
 }
 
+type configZPage struct {
+	// Status is nil if the configuration is not reloaded on changes.
+	Status *config.Status
+	Values []configZValue
+}
+
 // ServeHTTP serves the /configz endpoint that allows a user to view the configuration of the server.
 func (cz *configz) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	cfg, ok := cz.cfg.(*viper.Viper)
+	cfg, ok := cz.cfg.(interface {
+		AllSettings() map[string]interface{}
+	})
 	if !ok {
-		http.Error(w, "Configuration is not a *viper.Viper object", http.StatusInternalServerError)
+		http.Error(w, "Configuration does not list its settings", http.StatusInternalServerError)
+		return
 	}
 	values := []configZValue{}
 	settings := cfg.AllSettings()
@@ -72,13 +86,17 @@ func (cz *configz) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	sort.Slice(values, func(lhs int, rhs int) bool {
 		return strings.Compare(values[lhs].Key, values[rhs].Key) != 1
 	})
-	err := configPageTemplate.Execute(w, values)
+	page := configZPage{Values: values}
+	if status, ok := config.GetStatus(cz.cfg); ok {
+		page.Status = &status
+	}
+	err := configPageTemplate.Execute(w, page)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("cannot render HTML template, %s", err), http.StatusInternalServerError)
 	}
 	var b bytes.Buffer
 
-	err = configPageTemplate.ExecuteTemplate(bufio.NewWriter(&b), configZTemplateName, values)
+	err = configPageTemplate.ExecuteTemplate(bufio.NewWriter(&b), configZTemplateName, page)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("cannot render HTML template, %s", err), http.StatusInternalServerError)
 	}
@@ -15,13 +15,15 @@
 package telemetry
 
 import (
-	"contrib.go.opencensus.io/exporter/jaeger"
+	"net"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
-	"go.opencensus.io/trace"
+	"go.opentelemetry.io/otel/exporters/jaeger"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
-func bindJaeger(p Params, b Bindings) error {
+func bindJaeger(p Params, b Bindings, tp *sdktrace.TracerProvider) error {
 	cfg := p.Config()
 
 	if !cfg.GetBool("telemetry.jaeger.enable") {
@@ -31,27 +33,32 @@ func bindJaeger(p Params, b Bindings) error {
 
 	agentEndpointURI := cfg.GetString("telemetry.jaeger.agentEndpoint")
 	collectorEndpointURI := cfg.GetString("telemetry.jaeger.collectorEndpoint")
-	serviceName := p.ServiceName()
 
 	logger.WithFields(logrus.Fields{
 		"agentEndpoint":     agentEndpointURI,
 		"collectorEndpoint": collectorEndpointURI,
-		"serviceName":       serviceName,
+		"serviceName":       p.ServiceName(),
 	}).Info("Jaeger Tracing: ENABLED")
 
-	je, err := jaeger.NewExporter(jaeger.Options{
-		AgentEndpoint:     agentEndpointURI,
-		CollectorEndpoint: collectorEndpointURI,
-		ServiceName:       serviceName,
-	})
+	// The spans are sent to the collector if it is configured, to the agent
+	// otherwise.
+	var endpoint jaeger.EndpointOption
+	if collectorEndpointURI != "" {
+		endpoint = jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(collectorEndpointURI))
+	} else {
+		host, port, err := net.SplitHostPort(agentEndpointURI)
+		if err != nil {
+			return errors.Wrapf(err, "invalid Jaeger agent endpoint %s", agentEndpointURI)
+		}
+		endpoint = jaeger.WithAgentEndpoint(jaeger.WithAgentHost(host), jaeger.WithAgentPort(port))
+	}
+
+	je, err := jaeger.New(endpoint)
 	if err != nil {
 		return errors.Wrap(err, "Failed to create the Jaeger exporter")
 	}
 
-	trace.RegisterExporter(je)
-	b.AddCloser(func() {
-		trace.UnregisterExporter(je)
-	})
-
+	// The tracer provider flushes and shuts the exporter down on close.
+	tp.RegisterSpanProcessor(sdktrace.NewBatchSpanProcessor(je))
 	return nil
 }
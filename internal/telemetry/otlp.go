@@ -0,0 +1,149 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	ocbridge "go.opentelemetry.io/otel/bridge/opencensus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	configNameOtlpEnable   = "telemetry.otlp.enable"
+	configNameOtlpEndpoint = "telemetry.otlp.endpoint"
+	configNameOtlpTimeout  = "telemetry.otlp.timeout"
+
+	otlpMetricsPath = "/v1/metrics"
+	otlpTracesPath  = "/v1/traces"
+)
+
+// bindOtlp exports the metrics and the traces with the OpenTelemetry
+// protocol over HTTP to an OpenTelemetry collector. The OpenCensus views are
+// read through the OpenCensus bridge, with the trace exemplars of their
+// histograms.
+func bindOtlp(p Params, b Bindings, tp *sdktrace.TracerProvider) error {
+	cfg := p.Config()
+
+	if !cfg.GetBool(configNameOtlpEnable) {
+		logger.Info("OTLP: Disabled")
+		return nil
+	}
+
+	endpoint := strings.TrimSuffix(cfg.GetString(configNameOtlpEndpoint), "/")
+	if endpoint == "" {
+		return errors.Errorf("%s is required when %s is set", configNameOtlpEndpoint, configNameOtlpEnable)
+	}
+	timeout := 10 * time.Second
+	if cfg.IsSet(configNameOtlpTimeout) {
+		timeout = cfg.GetDuration(configNameOtlpTimeout)
+	}
+	interval := cfg.GetDuration("telemetry.reportingPeriod")
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	logger.WithFields(logrus.Fields{
+		"endpoint": endpoint,
+	}).Info("OTLP: ENABLED")
+
+	collector, err := parseOtlpEndpoint(endpoint)
+	if err != nil {
+		return err
+	}
+
+	te, err := otlptracehttp.New(context.Background(), collector.traceOptions(timeout)...)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create the OTLP trace exporter")
+	}
+	// The tracer provider flushes and shuts the exporter down on close.
+	tp.RegisterSpanProcessor(sdktrace.NewBatchSpanProcessor(te))
+
+	me, err := otlpmetrichttp.New(context.Background(), collector.metricOptions(timeout)...)
+	if err != nil {
+		return errors.Wrap(err, "Failed to create the OTLP metric exporter")
+	}
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(me,
+			sdkmetric.WithInterval(interval),
+			sdkmetric.WithProducer(ocbridge.NewMetricProducer()),
+		)),
+		sdkmetric.WithResource(newResource(p)),
+	)
+
+	b.AddCloserErr(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		// Exports the metrics collected since the last export.
+		return mp.Shutdown(ctx)
+	})
+	return nil
+}
+
+// otlpCollector is the address of the collector, taken apart as the OTLP
+// exporters expect it.
+type otlpCollector struct {
+	host     string
+	path     string
+	insecure bool
+}
+
+func parseOtlpEndpoint(endpoint string) (otlpCollector, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return otlpCollector{}, errors.Wrapf(err, "invalid %s", configNameOtlpEndpoint)
+	}
+	switch u.Scheme {
+	case "http", "https":
+	default:
+		return otlpCollector{}, errors.Errorf("%s must be an http or https URL, got %s", configNameOtlpEndpoint, endpoint)
+	}
+	return otlpCollector{
+		host:     u.Host,
+		path:     u.Path,
+		insecure: u.Scheme == "http",
+	}, nil
+}
+
+func (c otlpCollector) traceOptions(timeout time.Duration) []otlptracehttp.Option {
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(c.host),
+		otlptracehttp.WithURLPath(c.path + otlpTracesPath),
+		otlptracehttp.WithTimeout(timeout),
+	}
+	if c.insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	return options
+}
+
+func (c otlpCollector) metricOptions(timeout time.Duration) []otlpmetrichttp.Option {
+	options := []otlpmetrichttp.Option{
+		otlpmetrichttp.WithEndpoint(c.host),
+		otlpmetrichttp.WithURLPath(c.path + otlpMetricsPath),
+		otlpmetrichttp.WithTimeout(timeout),
+	}
+	if c.insecure {
+		options = append(options, otlpmetrichttp.WithInsecure())
+	}
+	return options
+}
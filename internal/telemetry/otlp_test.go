@@ -0,0 +1,112 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"open-match.dev/open-match/internal/config"
+)
+
+type fakeParams struct {
+	cfg config.View
+}
+
+func (p fakeParams) Config() config.View { return p.cfg }
+func (p fakeParams) ServiceName() string { return "backend" }
+
+type fakeBindings struct {
+	closers []func() error
+}
+
+func (b *fakeBindings) TelemetryHandle(pattern string, handler http.Handler) {}
+func (b *fakeBindings) TelemetryHandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+}
+func (b *fakeBindings) AddCloser(c func()) {
+	b.AddCloserErr(func() error {
+		c()
+		return nil
+	})
+}
+func (b *fakeBindings) AddCloserErr(c func() error) { b.closers = append(b.closers, c) }
+
+func (b *fakeBindings) close() error {
+	for _, c := range b.closers {
+		if err := c(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestOtlpExportsViews(t *testing.T) {
+	require := require.New(t)
+
+	var mu sync.Mutex
+	paths := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		paths[r.URL.Path] = r.Header.Get("Content-Type")
+	}))
+	defer server.Close()
+
+	measure := stats.Int64("test/otlp_count", "Count", stats.UnitDimensionless)
+	v := &view.View{
+		Name:        "test/otlp_count",
+		Measure:     measure,
+		Aggregation: view.Count(),
+	}
+	require.NoError(view.Register(v))
+	defer view.Unregister(v)
+
+	cfg := viper.New()
+	cfg.Set(configNameOtlpEnable, true)
+	cfg.Set(configNameOtlpEndpoint, server.URL+"/")
+	b := &fakeBindings{}
+	require.NoError(bindOtlp(fakeParams{cfg: cfg}, b, sdktrace.NewTracerProvider()))
+
+	stats.Record(context.Background(), measure.M(1))
+	// The metrics collected since the last export are sent on close.
+	require.NoError(b.close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(map[string]string{otlpMetricsPath: "application/x-protobuf"}, paths)
+}
+
+func TestParseOtlpEndpoint(t *testing.T) {
+	require := require.New(t)
+
+	c, err := parseOtlpEndpoint("http://otel-collector:4318/otlp")
+	require.NoError(err)
+	require.Equal(otlpCollector{host: "otel-collector:4318", path: "/otlp", insecure: true}, c)
+
+	c, err = parseOtlpEndpoint("https://otel-collector:4318")
+	require.NoError(err)
+	require.False(c.insecure)
+
+	_, err = parseOtlpEndpoint("otel-collector:4318")
+	require.Error(err)
+}
@@ -16,11 +16,21 @@ package telemetry
 
 // Taken from https://opencensus.io/quickstart/go/metrics/#1
 import (
+	"context"
+	"strings"
+	"sync"
+	"unicode"
+
 	ocPrometheus "contrib.go.opencensus.io/exporter/prometheus"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/sirupsen/logrus"
+	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -28,6 +38,22 @@ const (
 	ConfigNameEnableMetrics = "telemetry.prometheus.enable"
 )
 
+// The histograms of the views registered with RegisterExemplarViews are
+// exported by Prometheus directly, the OpenCensus exporter dropping the
+// exemplars.
+var (
+	exemplarRegistry = prometheus.NewRegistry()
+
+	exemplarMu         sync.RWMutex
+	exemplarHistograms = map[string][]*exemplarHistogram{}
+	exemplarNames      = map[string]struct{}{}
+)
+
+type exemplarHistogram struct {
+	vec     *prometheus.HistogramVec
+	tagKeys []tag.Key
+}
+
 func bindPrometheus(p Params, b Bindings) error {
 	cfg := p.Config()
 
@@ -68,6 +94,117 @@ func bindPrometheus(p Params, b Bindings) error {
 		view.UnregisterExporter(promExporter)
 	})
 
-	b.TelemetryHandle(endpoint, promExporter)
+	// The exemplars are only served in the OpenMetrics format, which
+	// Prometheus negotiates when its exemplar storage is enabled.
+	b.TelemetryHandle(endpoint, promhttp.HandlerFor(
+		prometheus.Gatherers{withoutExemplarViews(registry), exemplarRegistry},
+		promhttp.HandlerOpts{EnableOpenMetrics: true},
+	))
+	return nil
+}
+
+// RegisterExemplarViews creates the Prometheus histograms of the distribution
+// views, to which RecordWithExemplar adds the trace exemplars. They replace
+// the histograms of the OpenCensus exporter, and have the same names and
+// labels.
+func RegisterExemplarViews(views ...*view.View) error {
+	exemplarMu.Lock()
+	defer exemplarMu.Unlock()
+
+	for _, v := range views {
+		if v.Aggregation.Type != view.AggTypeDistribution {
+			return errors.Errorf("view %s is not a distribution", v.Name)
+		}
+		name := prometheusName(v.Name)
+		if _, ok := exemplarNames[name]; ok {
+			continue
+		}
+
+		labels := make([]string, len(v.TagKeys))
+		for i, k := range v.TagKeys {
+			labels[i] = prometheusName(k.Name())
+		}
+		vec := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    name,
+			Help:    v.Description,
+			Buckets: v.Aggregation.Buckets,
+		}, labels)
+		if err := exemplarRegistry.Register(vec); err != nil {
+			return errors.Wrapf(err, "Failed to register the histogram of view %s", v.Name)
+		}
+
+		measure := v.Measure.Name()
+		exemplarHistograms[measure] = append(exemplarHistograms[measure], &exemplarHistogram{
+			vec:     vec,
+			tagKeys: v.TagKeys,
+		})
+		exemplarNames[name] = struct{}{}
+	}
 	return nil
 }
+
+// observeExemplars adds the measurements to the Prometheus histograms of their
+// views, with the trace id of the sampled span of ctx as exemplar.
+func observeExemplars(ctx context.Context, ms []stats.Measurement) {
+	exemplarMu.RLock()
+	defer exemplarMu.RUnlock()
+
+	var exemplar prometheus.Labels
+	if sc := oteltrace.SpanContextFromContext(ctx); sc.IsSampled() {
+		exemplar = prometheus.Labels{
+			"trace_id": sc.TraceID().String(),
+			"span_id":  sc.SpanID().String(),
+		}
+	}
+	tags := tag.FromContext(ctx)
+
+	for _, m := range ms {
+		for _, h := range exemplarHistograms[m.Measure().Name()] {
+			values := make([]string, len(h.tagKeys))
+			for i, k := range h.tagKeys {
+				values[i], _ = tags.Value(k)
+			}
+			o := h.vec.WithLabelValues(values...)
+			if eo, ok := o.(prometheus.ExemplarObserver); ok && exemplar != nil {
+				eo.ObserveWithExemplar(m.Value(), exemplar)
+			} else {
+				o.Observe(m.Value())
+			}
+		}
+	}
+}
+
+// withoutExemplarViews removes the histograms exported natively from the
+// metrics of the OpenCensus exporter.
+func withoutExemplarViews(g prometheus.Gatherer) prometheus.Gatherer {
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		families, err := g.Gather()
+
+		exemplarMu.RLock()
+		defer exemplarMu.RUnlock()
+		kept := families[:0]
+		for _, f := range families {
+			if _, ok := exemplarNames[f.GetName()]; !ok {
+				kept = append(kept, f)
+			}
+		}
+		return kept, err
+	})
+}
+
+// prometheusName sanitizes the names as the OpenCensus Prometheus exporter.
+func prometheusName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
+	if name != "" && unicode.IsDigit(rune(name[0])) {
+		name = "key_" + name
+	}
+	if strings.HasPrefix(name, "_") {
+		name = "key" + name
+	}
+	return name
+}
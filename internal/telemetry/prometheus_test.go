@@ -0,0 +1,100 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestPrometheusExemplars(t *testing.T) {
+	require := require.New(t)
+
+	measure := stats.Float64("test/exemplar_latency", "Latency", stats.UnitMilliseconds)
+	v := &view.View{
+		Name:        "test/exemplar_latency",
+		Measure:     measure,
+		Aggregation: view.Distribution(10, 100),
+		TagKeys:     []tag.Key{KeyProfile},
+	}
+	require.NoError(RegisterExemplarViews(v))
+	// Registering the views again, when the services are bound again, is a
+	// no-op.
+	require.NoError(RegisterExemplarViews(v))
+	require.Error(RegisterExemplarViews(&view.View{
+		Name:        "test/exemplar_count",
+		Measure:     measure,
+		Aggregation: view.Count(),
+	}))
+
+	ctx, err := tag.New(context.Background(), tag.Upsert(KeyProfile, "1v1"))
+	require.NoError(err)
+	ctx = trace.ContextWithSpanContext(ctx, testSpanContext)
+	RecordWithExemplar(ctx, measure.M(50))
+
+	families, err := exemplarRegistry.Gather()
+	require.NoError(err)
+	var histogram *dto.Histogram
+	for _, f := range families {
+		if f.GetName() == "test_exemplar_latency" {
+			m := f.GetMetric()[0]
+			require.Equal("profile", m.GetLabel()[0].GetName())
+			require.Equal("1v1", m.GetLabel()[0].GetValue())
+			histogram = m.GetHistogram()
+		}
+	}
+	require.NotNil(histogram)
+	require.Equal(uint64(1), histogram.GetSampleCount())
+	exemplar := histogram.GetBucket()[1].GetExemplar()
+	require.NotNil(exemplar)
+	require.Equal(50.0, exemplar.GetValue())
+	labels := map[string]string{}
+	for _, l := range exemplar.GetLabel() {
+		labels[l.GetName()] = l.GetValue()
+	}
+	require.Equal(map[string]string{
+		"trace_id": "0102030405060708090a0b0c0d0e0f10",
+		"span_id":  "0102030405060708",
+	}, labels)
+
+	// The histogram of the OpenCensus exporter is replaced.
+	filtered, err := withoutExemplarViews(prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		return []*dto.MetricFamily{
+			{Name: stringPtr("test_exemplar_latency")},
+			{Name: stringPtr("test_other")},
+		}, nil
+	})).Gather()
+	require.NoError(err)
+	require.Len(filtered, 1)
+	require.Equal("test_other", filtered[0].GetName())
+}
+
+func TestPrometheusName(t *testing.T) {
+	require.Equal(t, "open_match_dev_backend_mmf_latency", prometheusName("open-match.dev/backend/mmf_latency"))
+	require.Equal(t, "key_1v1", prometheusName("1v1"))
+	require.Equal(t, "key_profile", prometheusName("_profile"))
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
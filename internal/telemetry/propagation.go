@@ -0,0 +1,57 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc/stats"
+	"open-match.dev/open-match/internal/config"
+)
+
+// IsInstrumented returns true if the RPCs must be instrumented, because the
+// metrics or the traces are exported.
+func IsInstrumented(cfg config.View) bool {
+	return cfg.GetBool(ConfigNameEnableMetrics) ||
+		cfg.GetBool("telemetry.jaeger.enable") ||
+		cfg.GetBool("telemetry.opencensusAgent.enable") ||
+		cfg.GetBool(configNameOtlpEnable)
+}
+
+// GRPCServerHandler traces the gRPC calls received by a server, as children
+// of the span context given by the caller in the W3C or B3 metadata.
+func GRPCServerHandler() stats.Handler {
+	return otelgrpc.NewServerHandler()
+}
+
+// GRPCClientHandler traces the gRPC calls of a client, and sends their span
+// context in the W3C and B3 metadata.
+func GRPCClientHandler() stats.Handler {
+	return otelgrpc.NewClientHandler()
+}
+
+// HTTPHandler traces the HTTP requests received by handler, as children of
+// the span context given by the caller in the W3C or B3 headers.
+func HTTPHandler(handler http.Handler, operation string) http.Handler {
+	return otelhttp.NewHandler(handler, operation)
+}
+
+// HTTPTransport traces the HTTP requests sent with base, and sends their span
+// context in the W3C and B3 headers.
+func HTTPTransport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base)
+}
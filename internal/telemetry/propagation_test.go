@@ -0,0 +1,87 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var testSpanContext = trace.NewSpanContext(trace.SpanContextConfig{
+	TraceID:    trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+	SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+	TraceFlags: trace.FlagsSampled,
+	Remote:     true,
+})
+
+func TestPropagator(t *testing.T) {
+	require := require.New(t)
+	p := newPropagator()
+
+	header := http.Header{}
+	p.Inject(trace.ContextWithSpanContext(context.Background(), testSpanContext), propagation.HeaderCarrier(header))
+	require.Equal("00-0102030405060708090a0b0c0d0e0f10-0102030405060708-01", header.Get("traceparent"))
+	require.Equal("0102030405060708090a0b0c0d0e0f10", header.Get("X-B3-TraceId"))
+
+	sc := trace.SpanContextFromContext(p.Extract(context.Background(), propagation.HeaderCarrier(header)))
+	require.Equal(testSpanContext.TraceID(), sc.TraceID())
+	require.Equal(testSpanContext.SpanID(), sc.SpanID())
+
+	// B3 only requests are still accepted.
+	header.Del("traceparent")
+	sc = trace.SpanContextFromContext(p.Extract(context.Background(), propagation.HeaderCarrier(header)))
+	require.Equal(testSpanContext.TraceID(), sc.TraceID())
+
+	// The W3C trace context takes precedence.
+	header.Set("traceparent", "00-1102030405060708090a0b0c0d0e0f10-0102030405060708-01")
+	sc = trace.SpanContextFromContext(p.Extract(context.Background(), propagation.HeaderCarrier(header)))
+	require.Equal("1102030405060708090a0b0c0d0e0f10", sc.TraceID().String())
+}
+
+func TestHTTPTracePropagation(t *testing.T) {
+	require := require.New(t)
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(newPropagator())
+
+	var serverSpan trace.SpanContext
+	server := httptest.NewServer(HTTPHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverSpan = trace.SpanContextFromContext(r.Context())
+	}), "mmf"))
+	defer server.Close()
+
+	ctx, parent := tp.Tracer(InstrumentationName).Start(context.Background(), "parent")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/matchfunction:run", nil)
+	require.NoError(err)
+	client := &http.Client{Transport: HTTPTransport(http.DefaultTransport)}
+	resp, err := client.Do(req)
+	require.NoError(err)
+	resp.Body.Close()
+	parent.End()
+
+	require.Equal(parent.SpanContext().TraceID(), serverSpan.TraceID())
+	require.NotEqual(parent.SpanContext().SpanID(), serverSpan.SpanID())
+	require.Len(recorder.Ended(), 3)
+}
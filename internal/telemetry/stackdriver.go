@@ -19,7 +19,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/stats/view"
-	"go.opencensus.io/trace"
 )
 
 func bindStackDriverMetrics(p Params, b Bindings) error {
@@ -46,12 +45,12 @@ func bindStackDriverMetrics(p Params, b Bindings) error {
 		return errors.Wrap(err, "Failed to initialize OpenCensus exporter to Stack Driver")
 	}
 
+	// The spans are traced with OpenTelemetry, they are exported to Cloud
+	// Trace through an OpenTelemetry collector with OTLP.
 	view.RegisterExporter(sd)
-	trace.RegisterExporter(sd)
 
 	b.AddCloser(func() {
 		view.UnregisterExporter(sd)
-		trace.UnregisterExporter(sd)
 		// It is imperative to invoke flush before your main function exits
 		sd.Flush()
 	})
@@ -0,0 +1,139 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"go.opencensus.io/metric/metricdata"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"go.opencensus.io/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"open-match.dev/open-match/internal/config"
+)
+
+const (
+	configNameMaxTagValues = "telemetry.maxTagValues"
+	defaultMaxTagValues    = 100
+
+	// OtherTagValue replaces the tag values seen after the limit of distinct
+	// values of a key is reached.
+	OtherTagValue = "other"
+
+	// maxTagValueLength is the longest tag value OpenCensus accepts.
+	maxTagValueLength = 255
+)
+
+// Tag keys shared by the metrics of the Open Match components.
+var (
+	// KeyProfile is the name of the match profile.
+	KeyProfile = tag.MustNewKey("profile")
+	// KeyFunction is the address of the match function.
+	KeyFunction = tag.MustNewKey("function")
+)
+
+// TagLimiter bounds the number of distinct values of the tag keys, so that
+// user provided values such as profile names don't create an unbounded
+// number of time series.
+type TagLimiter struct {
+	max int
+
+	mu     sync.Mutex
+	values map[tag.Key]map[string]struct{}
+}
+
+// NewTagLimiter creates a TagLimiter allowing telemetry.maxTagValues distinct
+// values per key.
+func NewTagLimiter(cfg config.View) *TagLimiter {
+	max := defaultMaxTagValues
+	if cfg.IsSet(configNameMaxTagValues) {
+		max = cfg.GetInt(configNameMaxTagValues)
+	}
+	return &TagLimiter{
+		max:    max,
+		values: make(map[tag.Key]map[string]struct{}),
+	}
+}
+
+// Value returns v if it is one of the first values seen for k, OtherTagValue
+// otherwise. v is sanitized first, so that the value is always accepted by
+// tag.New.
+func (l *TagLimiter) Value(k tag.Key, v string) string {
+	v = sanitizeTagValue(v)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	values, ok := l.values[k]
+	if !ok {
+		values = make(map[string]struct{})
+		l.values[k] = values
+	}
+	if _, ok := values[v]; ok {
+		return v
+	}
+	if len(values) >= l.max {
+		return OtherTagValue
+	}
+	values[v] = struct{}{}
+	return v
+}
+
+// sanitizeTagValue replaces the characters which are not printable ASCII with
+// '_' and truncates v to maxTagValueLength.
+func sanitizeTagValue(v string) string {
+	var b strings.Builder
+	for _, r := range v {
+		if b.Len() == maxTagValueLength {
+			break
+		}
+		if r < 0x20 || r > 0x7e {
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Upsert returns a mutator setting k to the limited value of v.
+func (l *TagLimiter) Upsert(k tag.Key, v string) tag.Mutator {
+	return tag.Upsert(k, l.Value(k, v))
+}
+
+// RecordWithExemplar records the measurements with the span of ctx attached,
+// so that the exporters supporting exemplars can link the histogram buckets
+// to the traces. The measurements are also added to the Prometheus histograms
+// of the views registered with RegisterExemplarViews.
+func RecordWithExemplar(ctx context.Context, ms ...stats.Measurement) {
+	observeExemplars(ctx, ms)
+
+	options := []stats.Options{stats.WithMeasurements(ms...)}
+	if sc := oteltrace.SpanContextFromContext(ctx); sc.IsSampled() {
+		// The exporters read the OpenCensus span context of the exemplars.
+		options = append(options, stats.WithAttachments(metricdata.Attachments{
+			metricdata.AttachmentKeySpanContext: trace.SpanContext{
+				TraceID:      trace.TraceID(sc.TraceID()),
+				SpanID:       trace.SpanID(sc.SpanID()),
+				TraceOptions: 1,
+			},
+		}))
+	}
+	if err := stats.RecordWithOptions(ctx, options...); err != nil {
+		logger.WithError(err).Info("cannot record stat with exemplar")
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"go.opencensus.io/tag"
+)
+
+func TestTagLimiter(t *testing.T) {
+	require := require.New(t)
+	cfg := viper.New()
+	cfg.Set("telemetry.maxTagValues", 2)
+	l := NewTagLimiter(cfg)
+
+	require.Equal("a", l.Value(KeyProfile, "a"))
+	require.Equal("b", l.Value(KeyProfile, "b"))
+	require.Equal(OtherTagValue, l.Value(KeyProfile, "c"))
+	require.Equal("a", l.Value(KeyProfile, "a"))
+
+	// Each key has its own limit.
+	require.Equal("c", l.Value(KeyFunction, "c"))
+
+	require.Equal(defaultMaxTagValues, NewTagLimiter(viper.New()).max)
+}
+
+func TestTagLimiterSanitizesValues(t *testing.T) {
+	require := require.New(t)
+	l := NewTagLimiter(viper.New())
+
+	require.Equal("ranked_1v1_", l.Value(KeyProfile, "ranked\t1v1é"))
+	long := l.Value(KeyProfile, strings.Repeat("a", 300))
+	require.Len(long, maxTagValueLength)
+
+	// The sanitized values are accepted by OpenCensus.
+	_, err := tag.New(context.Background(),
+		l.Upsert(KeyProfile, "プロファイル"),
+		l.Upsert(KeyFunction, strings.Repeat("b", 1000)),
+	)
+	require.NoError(err)
+}
@@ -0,0 +1,85 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/propagators/b3"
+	"go.opentelemetry.io/otel"
+	ocbridge "go.opentelemetry.io/otel/bridge/opencensus"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+)
+
+const (
+	// InstrumentationName names the tracers and the meters of Open Match.
+	InstrumentationName = "open-match.dev/open-match"
+
+	// shutdownTimeout bounds the export of the telemetry buffered when the
+	// server stops.
+	shutdownTimeout = 10 * time.Second
+)
+
+// configureTracing installs the OpenTelemetry tracer provider, to which the
+// trace exporters add their span processors, and the propagators of the trace
+// context. Spans still started with the OpenCensus API are bridged to the
+// OpenTelemetry tracer, so that they are part of the same traces.
+func configureTracing(p Params, b Bindings) *sdktrace.TracerProvider {
+	samplingFraction := p.Config().GetFloat64("telemetry.traceSamplingFraction")
+	logger.WithFields(logrus.Fields{
+		"samplingFraction": samplingFraction,
+	}).Info("Tracing sampler fraction set")
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingFraction))),
+		sdktrace.WithResource(newResource(p)),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(newPropagator())
+	ocbridge.InstallTraceBridge(ocbridge.WithTracerProvider(tp))
+
+	b.AddCloserErr(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		// Exports the spans ended since the last export.
+		return tp.Shutdown(ctx)
+	})
+	return tp
+}
+
+// newPropagator propagates the trace context in the W3C traceparent and
+// tracestate headers. The B3 headers are also accepted and sent, for the
+// clients and servers which don't support the W3C trace context. The W3C
+// headers take precedence when a request has both.
+func newPropagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(
+		b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader)),
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	)
+}
+
+// newResource describes the Open Match component in the exported telemetry.
+func newResource(p Params) *resource.Resource {
+	return resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceNamespace("open-match"),
+		semconv.ServiceName(p.ServiceName()),
+	)
+}
@@ -77,6 +77,72 @@ func TestHappyPath(t *testing.T) {
 	require.Nil(t, resp)
 }
 
+// TestWatchMatches covers that matches returned by FetchMatches are streamed
+// to the WatchMatches callers interested in the match profile.
+func TestWatchMatches(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	om := newOM(t)
+
+	t1, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.Nil(t, err)
+
+	m := &pb.Match{
+		MatchId:       "1",
+		MatchProfile:  "watched",
+		MatchFunction: "mmf",
+		Tickets:       []*pb.Ticket{t1},
+	}
+
+	om.SetMMF(func(ctx context.Context, profile *pb.MatchProfile, out chan<- *pb.Match) error {
+		out <- m
+		return nil
+	})
+	om.SetEvaluator(func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+		for p := range in {
+			out <- p.MatchId
+		}
+		return nil
+	})
+
+	watched, err := om.Backend().WatchMatches(ctx, &pb.WatchMatchesRequest{ProfileNames: []string{"watched"}})
+	require.Nil(t, err)
+	other, err := om.Backend().WatchMatches(ctx, &pb.WatchMatchesRequest{ProfileNames: []string{"other"}})
+	require.Nil(t, err)
+
+	// Headers are sent once the streams are registered.
+	_, err = watched.Header()
+	require.Nil(t, err)
+	_, err = other.Header()
+	require.Nil(t, err)
+
+	stream, err := om.Backend().FetchMatches(ctx, &pb.FetchMatchesRequest{
+		Config:  om.MMFConfigGRPC(),
+		Profile: &pb.MatchProfile{Name: "watched"},
+	})
+	require.Nil(t, err)
+
+	resp, err := stream.Recv()
+	require.Nil(t, err)
+	require.True(t, proto.Equal(m, resp.Match))
+
+	watchResp, err := watched.Recv()
+	require.Nil(t, err)
+	require.True(t, proto.Equal(m, watchResp.Match))
+
+	// The match of another profile is never streamed.
+	received := make(chan error, 1)
+	go func() {
+		_, err := other.Recv()
+		received <- err
+	}()
+	select {
+	case err = <-received:
+		require.Fail(t, "unexpected match or error on the other stream", "%v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 // TestMatchFunctionMatchCollision covers two matches with the same id coming
 // from the same MMF generates an error to the fetch matches call.  Also ensures
 // another function running in the same cycle does not experience an error.
@@ -217,3 +217,53 @@ func returnedByQueryID(t *testing.T, tc testcases.TestCase) (found bool) {
 
 	return len(ids) == 1
 }
+
+func TestTicketQueryAfterUpdate(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	ticket, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{
+		SearchFields: &pb.SearchFields{
+			StringArgs: map[string]string{
+				"mode": "casual",
+			},
+		},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), ticket.Generation)
+
+	query := func(mode string) []*pb.Ticket {
+		stream, err := om.Query().QueryTickets(ctx, &pb.QueryTicketsRequest{Pool: &pb.Pool{
+			StringEqualsFilters: []*pb.StringEqualsFilter{{StringArg: "mode", Value: mode}},
+		}})
+		require.NoError(t, err)
+
+		tickets := []*pb.Ticket{}
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+			tickets = append(tickets, resp.Tickets...)
+		}
+		return tickets
+	}
+
+	require.Len(t, query("casual"), 1)
+
+	ticket.SearchFields.StringArgs["mode"] = "ranked"
+	updated, err := om.Frontend().UpdateTicket(ctx, &pb.UpdateTicketRequest{Ticket: ticket})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), updated.Generation)
+
+	require.Len(t, query("casual"), 0)
+	tickets := query("ranked")
+	require.Len(t, tickets, 1)
+	require.Equal(t, ticket.Id, tickets[0].Id)
+	require.Equal(t, int64(2), tickets[0].Generation)
+
+	// Updating with a stale generation is rejected.
+	_, err = om.Frontend().UpdateTicket(ctx, &pb.UpdateTicketRequest{Ticket: ticket})
+	require.Equal(t, codes.Aborted.String(), status.Convert(err).Code().String())
+}
@@ -646,3 +646,81 @@ func TestWatchAssignments(t *testing.T) {
 		require.Equal(t, "a", a.Connection)
 	}
 }
+
+// TestUpdateAssignedTicket covers that assigned tickets can not be updated.
+func TestUpdateAssignedTicket(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	t1, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.NoError(t, err)
+
+	resp, err := om.Backend().AssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{t1.Id},
+				Assignment: &pb.Assignment{Connection: "a"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Failures, 0)
+
+	_, err = om.Frontend().UpdateTicket(ctx, &pb.UpdateTicketRequest{Ticket: t1})
+	require.Equal(t, codes.FailedPrecondition.String(), status.Convert(err).Code().String())
+}
+
+// TestRequeueTickets covers requeueing an acknowledged assignment, making the
+// ticket available for matchmaking again.
+func TestRequeueTickets(t *testing.T) {
+	om := newOM(t)
+	ctx := context.Background()
+
+	t1, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{
+		SearchFields: &pb.SearchFields{Tags: []string{"requeue"}},
+	}})
+	require.NoError(t, err)
+	t2, err := om.Frontend().CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: &pb.Ticket{}})
+	require.NoError(t, err)
+
+	_, err = om.Frontend().AcknowledgeAssignment(ctx, &pb.AcknowledgeAssignmentRequest{TicketId: t1.Id})
+	require.Equal(t, codes.FailedPrecondition.String(), status.Convert(err).Code().String())
+
+	resp, err := om.Backend().AssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{
+			{
+				TicketIds:  []string{t1.Id},
+				Assignment: &pb.Assignment{Connection: "a"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Failures, 0)
+
+	acked, err := om.Frontend().AcknowledgeAssignment(ctx, &pb.AcknowledgeAssignmentRequest{TicketId: t1.Id})
+	require.NoError(t, err)
+	require.NotNil(t, acked.AssignmentAcknowledgeTime)
+
+	requeueResp, err := om.Backend().RequeueTickets(ctx, &pb.RequeueTicketsRequest{TicketIds: []string{t1.Id, t2.Id}})
+	require.NoError(t, err)
+	require.Len(t, requeueResp.Failures, 1)
+	require.Equal(t, t2.Id, requeueResp.Failures[0].TicketId)
+	require.Equal(t, pb.AssignmentFailure_TICKET_NOT_ASSIGNED, requeueResp.Failures[0].Cause)
+
+	get, err := om.Frontend().GetTicket(ctx, &pb.GetTicketRequest{TicketId: t1.Id})
+	require.NoError(t, err)
+	require.Nil(t, get.Assignment)
+	require.True(t, proto.Equal(t1.CreateTime, get.CreateTime))
+	require.Len(t, get.AssignmentHistory, 1)
+	require.Equal(t, "a", get.AssignmentHistory[0].Assignment.Connection)
+	require.True(t, proto.Equal(acked.AssignmentAcknowledgeTime, get.AssignmentHistory[0].AcknowledgeTime))
+
+	stream, err := om.Query().QueryTickets(ctx, &pb.QueryTicketsRequest{Pool: &pb.Pool{
+		TagPresentFilters: []*pb.TagPresentFilter{{Tag: "requeue"}},
+	}})
+	require.NoError(t, err)
+	queryResp, err := stream.Recv()
+	require.NoError(t, err)
+	require.Len(t, queryResp.Tickets, 1)
+	require.Equal(t, t1.Id, queryResp.Tickets[0].Id)
+}
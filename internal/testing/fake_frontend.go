@@ -80,3 +80,13 @@ func (s *FakeFrontend) GetBackfill(ctx context.Context, req *pb.GetBackfillReque
 func (s *FakeFrontend) UpdateBackfill(ctx context.Context, req *pb.UpdateBackfillRequest) (*pb.Backfill, error) {
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
+
+// UpdateTicket updates the search fields and extensions of an indexed Ticket.
+func (s *FakeFrontend) UpdateTicket(ctx context.Context, req *pb.UpdateTicketRequest) (*pb.Ticket, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+// AcknowledgeAssignment records that the client received the Assignment of the specified Ticket.
+func (s *FakeFrontend) AcknowledgeAssignment(ctx context.Context, req *pb.AcknowledgeAssignmentRequest) (*pb.Ticket, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
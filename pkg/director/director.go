@@ -0,0 +1,378 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package director provides the loop run by a matchmaker director: it fetches
+// matches for sets of profiles on an interval, allocates a game server to
+// each match and assigns its tickets, and keeps the backfills of the
+// allocated game servers acknowledged.
+//
+//	d := director.New(backend, director.AllocatorFunc(allocate),
+//		director.StaticProfiles(&director.ProfileSet{Function: fn, Profiles: profiles}),
+//		director.WithFrontend(frontend))
+//	err := d.Run(ctx)
+package director
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	defaultInterval            = time.Second
+	defaultBackfillAckInterval = 10 * time.Second
+	// releaseTimeout bounds the release of the tickets of a match which
+	// couldn't be allocated or assigned, which also happens while the
+	// director stops.
+	releaseTimeout = 10 * time.Second
+)
+
+var (
+	logger = logrus.WithFields(logrus.Fields{
+		"app":       "director",
+		"component": "director",
+	})
+)
+
+// Allocator allocates a game server for a match and returns the assignment
+// the tickets of the match are given.
+type Allocator interface {
+	Allocate(ctx context.Context, match *pb.Match) (*pb.Assignment, error)
+}
+
+// AllocatorFunc adapts a function to the Allocator interface.
+type AllocatorFunc func(ctx context.Context, match *pb.Match) (*pb.Assignment, error)
+
+// Allocate calls f(ctx, match).
+func (f AllocatorFunc) Allocate(ctx context.Context, match *pb.Match) (*pb.Assignment, error) {
+	return f(ctx, match)
+}
+
+// NewAllocatorClient returns an Allocator calling an allocator service.
+func NewAllocatorClient(client pb.AllocatorClient) Allocator {
+	return AllocatorFunc(func(ctx context.Context, match *pb.Match) (*pb.Assignment, error) {
+		resp, err := client.Allocate(ctx, &pb.AllocateRequest{Match: match})
+		if err != nil {
+			return nil, err
+		}
+		return resp.GetAssignment(), nil
+	})
+}
+
+// ProfileSet is a set of profiles fetched with the same match function.
+type ProfileSet struct {
+	Function *pb.FunctionConfig
+	Profiles []*pb.MatchProfile
+}
+
+// ProfileSource returns the profile sets fetched on a cycle. It is called
+// at the start of every cycle, so its result may change between cycles.
+type ProfileSource interface {
+	ProfileSets() []*ProfileSet
+}
+
+type staticProfiles []*ProfileSet
+
+func (s staticProfiles) ProfileSets() []*ProfileSet {
+	return s
+}
+
+// StaticProfiles returns a ProfileSource always returning sets.
+func StaticProfiles(sets ...*ProfileSet) ProfileSource {
+	return staticProfiles(sets)
+}
+
+// Option configures a Director.
+type Option func(*Director)
+
+// WithFrontend sets the frontend client used to acknowledge and delete
+// backfills. Without it, matches with a backfill are assigned like the
+// others and no backfill is acknowledged.
+func WithFrontend(frontend pb.FrontendServiceClient) Option {
+	return func(d *Director) {
+		d.frontend = frontend
+	}
+}
+
+// WithInterval sets the time waited between two successful cycles.
+func WithInterval(interval time.Duration) Option {
+	return func(d *Director) {
+		d.interval = interval
+	}
+}
+
+// WithBackfillAckInterval sets the time between two acknowledgments of a live
+// backfill. It must be shorter than the backfill expiration of the
+// deployment, 80% of backfillLockTimeout.
+func WithBackfillAckInterval(interval time.Duration) Option {
+	return func(d *Director) {
+		d.ackInterval = interval
+	}
+}
+
+// WithBackOff sets the back off applied when a cycle fails. The back off is
+// reset after each successful cycle, and Run returns when it stops.
+func WithBackOff(b backoff.BackOff) Option {
+	return func(d *Director) {
+		d.backOff = b
+	}
+}
+
+// Director runs the fetch, allocation and backfill acknowledgment loops.
+type Director struct {
+	backend     pb.BackendServiceClient
+	frontend    pb.FrontendServiceClient
+	allocator   Allocator
+	profiles    ProfileSource
+	interval    time.Duration
+	ackInterval time.Duration
+	backOff     backoff.BackOff
+
+	mu        sync.Mutex
+	backfills map[string]*pb.Assignment
+}
+
+// New returns a Director fetching the profiles of source from backend and
+// allocating the matches with allocator.
+func New(backend pb.BackendServiceClient, allocator Allocator, source ProfileSource, opts ...Option) *Director {
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = 0
+
+	d := &Director{
+		backend:     backend,
+		allocator:   allocator,
+		profiles:    source,
+		interval:    defaultInterval,
+		ackInterval: defaultBackfillAckInterval,
+		backOff:     b,
+		backfills:   make(map[string]*pb.Assignment),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Run runs cycles until ctx is done or the back off stops after a failed
+// cycle, and acknowledges the live backfills in the meantime.
+func (d *Director) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if d.frontend != nil {
+		go d.runBackfillAcks(ctx)
+	}
+
+	d.backOff.Reset()
+	for {
+		wait := d.interval
+		if err := d.RunOnce(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			wait = d.backOff.NextBackOff()
+			if wait == backoff.Stop {
+				return err
+			}
+			logger.WithError(err).Warningf("Cycle failed, retrying in %s", wait)
+		} else {
+			d.backOff.Reset()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// RunOnce fetches the matches of every profile concurrently and allocates
+// them. It returns the first error of a FetchMatches call; failed
+// allocations and assignments are logged.
+func (d *Director) RunOnce(ctx context.Context) error {
+	// The failure of a fetch cancels the other fetches, but not the
+	// allocation of the matches already received, which use ctx.
+	eg, fetchCtx := errgroup.WithContext(ctx)
+	for _, set := range d.profiles.ProfileSets() {
+		for _, p := range set.Profiles {
+			set, p := set, p
+			eg.Go(func() error {
+				return d.fetch(fetchCtx, ctx, set.Function, p)
+			})
+		}
+	}
+	return eg.Wait()
+}
+
+func (d *Director) fetch(ctx, allocCtx context.Context, fn *pb.FunctionConfig, p *pb.MatchProfile) error {
+	stream, err := d.backend.FetchMatches(ctx, &pb.FetchMatchesRequest{Config: fn, Profile: p})
+	if err != nil {
+		return fmt.Errorf("error fetching matches for profile %s: %w", p.GetName(), err)
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error receiving matches for profile %s: %w", p.GetName(), err)
+		}
+		d.allocate(allocCtx, resp.GetMatch())
+	}
+}
+
+func (d *Director) allocate(ctx context.Context, m *pb.Match) {
+	ids := ticketIDs(m)
+
+	a, err := d.allocator.Allocate(ctx, m)
+	if err != nil {
+		logger.WithError(err).WithField("matchId", m.GetMatchId()).Error("Failed to allocate a game server, releasing the tickets")
+		d.release(ids)
+		return
+	}
+
+	if id := m.GetBackfill().GetId(); id != "" && d.frontend != nil {
+		d.mu.Lock()
+		d.backfills[id] = a
+		d.mu.Unlock()
+
+		// Acknowledging the backfill assigns the tickets associated with it.
+		if err = d.acknowledge(ctx, id, a); err != nil {
+			// The backfill is left to expire, and the tickets are matched
+			// again.
+			logger.WithError(err).WithField("matchId", m.GetMatchId()).Error("Failed to acknowledge the backfill of the match, releasing the tickets")
+			d.forget(id)
+			d.release(ids)
+		}
+		return
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+	resp, err := d.backend.AssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{TicketIds: ids, Assignment: a}},
+	})
+	if err != nil {
+		logger.WithError(err).WithField("matchId", m.GetMatchId()).Error("Failed to assign the tickets, releasing them")
+		d.release(ids)
+		return
+	}
+	for _, f := range resp.GetFailures() {
+		logger.WithFields(logrus.Fields{
+			"matchId":  m.GetMatchId(),
+			"ticketId": f.GetTicketId(),
+			"cause":    f.GetCause().String(),
+		}).Warning("Failed to assign ticket")
+	}
+}
+
+// release releases the tickets even if the cycle was canceled, so that they
+// don't wait for the pending release timeout to be matched again.
+func (d *Director) release(ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	if _, err := d.backend.ReleaseTickets(ctx, &pb.ReleaseTicketsRequest{TicketIds: ids}); err != nil {
+		logger.WithError(err).Errorf("Failed to release tickets %v", ids)
+	}
+}
+
+func (d *Director) runBackfillAcks(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.ackInterval):
+		}
+
+		d.mu.Lock()
+		backfills := make(map[string]*pb.Assignment, len(d.backfills))
+		for id, a := range d.backfills {
+			backfills[id] = a
+		}
+		d.mu.Unlock()
+
+		for id, a := range backfills {
+			// The failures are logged, the acknowledgment is retried on the
+			// next interval.
+			_ = d.acknowledge(ctx, id, a)
+		}
+	}
+}
+
+// acknowledge acknowledges the backfill id, and stops acknowledging it once
+// it no longer exists.
+func (d *Director) acknowledge(ctx context.Context, id string, a *pb.Assignment) error {
+	_, err := d.frontend.AcknowledgeBackfill(ctx, &pb.AcknowledgeBackfillRequest{BackfillId: id, Assignment: a})
+	if status.Code(err) == codes.NotFound {
+		logger.WithField("backfillId", id).Info("Backfill no longer exists, stopping its acknowledgment")
+		d.forget(id)
+		return err
+	}
+	if err != nil {
+		logger.WithError(err).WithField("backfillId", id).Error("Failed to acknowledge backfill")
+	}
+	return err
+}
+
+// Backfills returns the IDs of the backfills acknowledged periodically.
+func (d *Director) Backfills() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ids := make([]string, 0, len(d.backfills))
+	for id := range d.backfills {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// StopBackfill deletes the backfill id, typically once its game server is
+// full, and stops acknowledging it.
+func (d *Director) StopBackfill(ctx context.Context, id string) error {
+	d.forget(id)
+	if d.frontend == nil {
+		return status.Error(codes.FailedPrecondition, "director has no frontend client")
+	}
+	_, err := d.frontend.DeleteBackfill(ctx, &pb.DeleteBackfillRequest{BackfillId: id})
+	return err
+}
+
+func (d *Director) forget(id string) {
+	d.mu.Lock()
+	delete(d.backfills, id)
+	d.mu.Unlock()
+}
+
+func ticketIDs(m *pb.Match) []string {
+	ids := make([]string, 0, len(m.GetTickets()))
+	for _, t := range m.GetTickets() {
+		ids = append(ids, t.GetId())
+	}
+	return ids
+}
@@ -0,0 +1,262 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package director
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+type fakeStream struct {
+	pb.BackendService_FetchMatchesClient
+	matches []*pb.Match
+}
+
+func (s *fakeStream) Recv() (*pb.FetchMatchesResponse, error) {
+	if len(s.matches) == 0 {
+		return nil, io.EOF
+	}
+	m := s.matches[0]
+	s.matches = s.matches[1:]
+	return &pb.FetchMatchesResponse{Match: m}, nil
+}
+
+type fakeBackend struct {
+	pb.BackendServiceClient
+	matches  []*pb.Match
+	fetchErr error
+
+	mu       sync.Mutex
+	assigned map[string]string
+	released []string
+}
+
+func (b *fakeBackend) FetchMatches(ctx context.Context, req *pb.FetchMatchesRequest, opts ...grpc.CallOption) (pb.BackendService_FetchMatchesClient, error) {
+	if b.fetchErr != nil {
+		return nil, b.fetchErr
+	}
+	return &fakeStream{matches: b.matches}, nil
+}
+
+func (b *fakeBackend) AssignTickets(ctx context.Context, req *pb.AssignTicketsRequest, opts ...grpc.CallOption) (*pb.AssignTicketsResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, g := range req.GetAssignments() {
+		for _, id := range g.GetTicketIds() {
+			b.assigned[id] = g.GetAssignment().GetConnection()
+		}
+	}
+	return &pb.AssignTicketsResponse{}, nil
+}
+
+func (b *fakeBackend) ReleaseTickets(ctx context.Context, req *pb.ReleaseTicketsRequest, opts ...grpc.CallOption) (*pb.ReleaseTicketsResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.released = append(b.released, req.GetTicketIds()...)
+	return &pb.ReleaseTicketsResponse{}, nil
+}
+
+type fakeFrontend struct {
+	pb.FrontendServiceClient
+
+	ackErr error
+
+	mu      sync.Mutex
+	acks    map[string]int
+	deleted []string
+}
+
+func (f *fakeFrontend) AcknowledgeBackfill(ctx context.Context, req *pb.AcknowledgeBackfillRequest, opts ...grpc.CallOption) (*pb.AcknowledgeBackfillResponse, error) {
+	if f.ackErr != nil {
+		return nil, f.ackErr
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, id := range f.deleted {
+		if id == req.GetBackfillId() {
+			return nil, status.Errorf(codes.NotFound, "Backfill id: %s not found", id)
+		}
+	}
+	f.acks[req.GetBackfillId()]++
+	return &pb.AcknowledgeBackfillResponse{}, nil
+}
+
+func (f *fakeFrontend) DeleteBackfill(ctx context.Context, req *pb.DeleteBackfillRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleted = append(f.deleted, req.GetBackfillId())
+	return &empty.Empty{}, nil
+}
+
+func (f *fakeFrontend) ackCount(id string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.acks[id]
+}
+
+func match(id string, ticketIDs ...string) *pb.Match {
+	m := &pb.Match{MatchId: id}
+	for _, tid := range ticketIDs {
+		m.Tickets = append(m.Tickets, &pb.Ticket{Id: tid})
+	}
+	return m
+}
+
+func profiles() ProfileSource {
+	return StaticProfiles(&ProfileSet{
+		Function: &pb.FunctionConfig{Host: "om-function", Port: 50502, Type: pb.FunctionConfig_GRPC},
+		Profiles: []*pb.MatchProfile{{Name: "1v1"}},
+	})
+}
+
+func TestRunOnceAssigns(t *testing.T) {
+	require := require.New(t)
+
+	be := &fakeBackend{
+		matches:  []*pb.Match{match("a", "1", "2"), match("b", "3", "4")},
+		assigned: map[string]string{},
+	}
+	alloc := AllocatorFunc(func(ctx context.Context, m *pb.Match) (*pb.Assignment, error) {
+		return &pb.Assignment{Connection: m.GetMatchId() + ":2222"}, nil
+	})
+
+	require.NoError(New(be, alloc, profiles()).RunOnce(context.Background()))
+	require.Equal(map[string]string{"1": "a:2222", "2": "a:2222", "3": "b:2222", "4": "b:2222"}, be.assigned)
+	require.Empty(be.released)
+}
+
+func TestRunOnceReleasesOnAllocationFailure(t *testing.T) {
+	require := require.New(t)
+
+	be := &fakeBackend{
+		matches:  []*pb.Match{match("a", "1", "2"), match("b", "3", "4")},
+		assigned: map[string]string{},
+	}
+	alloc := AllocatorFunc(func(ctx context.Context, m *pb.Match) (*pb.Assignment, error) {
+		if m.GetMatchId() == "b" {
+			return nil, errors.New("no game server available")
+		}
+		return &pb.Assignment{Connection: "a:2222"}, nil
+	})
+
+	require.NoError(New(be, alloc, profiles()).RunOnce(context.Background()))
+	require.Equal(map[string]string{"1": "a:2222", "2": "a:2222"}, be.assigned)
+	require.ElementsMatch([]string{"3", "4"}, be.released)
+}
+
+// failingBackend fails the FetchMatches calls of the "fail" profile.
+type failingBackend struct {
+	*fakeBackend
+	failed chan struct{}
+}
+
+func (b *failingBackend) FetchMatches(ctx context.Context, req *pb.FetchMatchesRequest, opts ...grpc.CallOption) (pb.BackendService_FetchMatchesClient, error) {
+	if req.GetProfile().GetName() == "fail" {
+		close(b.failed)
+		return nil, status.Error(codes.Unavailable, "backend unavailable")
+	}
+	return b.fakeBackend.FetchMatches(ctx, req, opts...)
+}
+
+func TestRunOnceFetchErrorDoesNotCancelAllocations(t *testing.T) {
+	require := require.New(t)
+
+	be := &failingBackend{
+		fakeBackend: &fakeBackend{matches: []*pb.Match{match("a", "1", "2")}, assigned: map[string]string{}},
+		failed:      make(chan struct{}),
+	}
+	alloc := AllocatorFunc(func(ctx context.Context, m *pb.Match) (*pb.Assignment, error) {
+		// Leaves time to the error group to cancel its context.
+		<-be.failed
+		time.Sleep(10 * time.Millisecond)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return &pb.Assignment{Connection: "a:2222"}, nil
+	})
+	source := StaticProfiles(&ProfileSet{
+		Function: &pb.FunctionConfig{Host: "om-function", Port: 50502, Type: pb.FunctionConfig_GRPC},
+		Profiles: []*pb.MatchProfile{{Name: "1v1"}, {Name: "fail"}},
+	})
+
+	err := New(be, alloc, source).RunOnce(context.Background())
+	require.Equal(codes.Unavailable, status.Code(errors.Unwrap(err)))
+	require.Equal(map[string]string{"1": "a:2222", "2": "a:2222"}, be.assigned)
+	require.Empty(be.released)
+}
+
+func TestRunOnceFetchError(t *testing.T) {
+	be := &fakeBackend{fetchErr: status.Error(codes.Unavailable, "backend unavailable")}
+	alloc := AllocatorFunc(func(ctx context.Context, m *pb.Match) (*pb.Assignment, error) {
+		return &pb.Assignment{}, nil
+	})
+
+	err := New(be, alloc, profiles()).RunOnce(context.Background())
+	require.Equal(t, codes.Unavailable, status.Code(errors.Unwrap(err)))
+}
+
+func TestBackfillAcknowledgment(t *testing.T) {
+	require := require.New(t)
+
+	m := match("a", "1")
+	m.Backfill = &pb.Backfill{Id: "bf"}
+	be := &fakeBackend{matches: []*pb.Match{m}, assigned: map[string]string{}}
+	fe := &fakeFrontend{acks: map[string]int{}}
+	alloc := AllocatorFunc(func(ctx context.Context, m *pb.Match) (*pb.Assignment, error) {
+		return &pb.Assignment{Connection: "a:2222"}, nil
+	})
+
+	d := New(be, alloc, profiles(), WithFrontend(fe), WithInterval(time.Hour), WithBackfillAckInterval(time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go d.Run(ctx)
+
+	require.Eventually(func() bool { return fe.ackCount("bf") > 2 }, time.Second, time.Millisecond)
+	require.Empty(be.assigned)
+	require.Equal([]string{"bf"}, d.Backfills())
+
+	require.NoError(d.StopBackfill(ctx, "bf"))
+	require.Empty(d.Backfills())
+	require.Equal([]string{"bf"}, fe.deleted)
+}
+
+func TestRunOnceReleasesOnBackfillAcknowledgmentFailure(t *testing.T) {
+	require := require.New(t)
+
+	m := match("a", "1", "2")
+	m.Backfill = &pb.Backfill{Id: "bf"}
+	be := &fakeBackend{matches: []*pb.Match{m}, assigned: map[string]string{}}
+	fe := &fakeFrontend{acks: map[string]int{}, ackErr: status.Error(codes.Unavailable, "frontend unavailable")}
+	alloc := AllocatorFunc(func(ctx context.Context, m *pb.Match) (*pb.Assignment, error) {
+		return &pb.Assignment{Connection: "a:2222"}, nil
+	})
+
+	d := New(be, alloc, profiles(), WithFrontend(fe))
+	require.NoError(d.RunOnce(context.Background()))
+	require.ElementsMatch([]string{"1", "2"}, be.released)
+	require.Empty(be.assigned)
+	require.Empty(d.Backfills())
+}
@@ -0,0 +1,309 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package director
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/golang/protobuf/jsonpb"
+	"gopkg.in/yaml.v2"
+	"open-match.dev/open-match/internal/filter"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// DefaultFunction is the name of the function used by the profiles of a
+// profile file that do not name one.
+const DefaultFunction = "default"
+
+// ParseProfiles parses and validates a profile file, in YAML or JSON. The
+// file declares the match functions by name, and the profiles with the name
+// of the function they are fetched with:
+//
+//	functions:
+//	  default:
+//	    host: om-function
+//	    port: 50502
+//	    type: GRPC
+//	profiles:
+//	- name: 1v1
+//	  function: default
+//	  pools:
+//	  - name: everyone
+//	    tagPresentFilters:
+//	    - tag: mode.1v1
+//	    doubleRangeFilters:
+//	    - doubleArg: skill
+//	      min: 0
+//	      max: 100
+//	  extensions:
+//	    team_size:
+//	      "@type": type.googleapis.com/google.protobuf.Int32Value
+//	      value: 1
+//
+// Functions are pb.FunctionConfig and profiles pb.MatchProfile in their JSON
+// mapping, so extensions are typed with "@type". The returned sets are
+// ordered by function name.
+func ParseProfiles(data []byte) ([]*ProfileSet, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("cannot parse the profile file: %w", err)
+	}
+	js, err := json.Marshal(jsonValue(raw))
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse the profile file: %w", err)
+	}
+
+	var file struct {
+		Functions map[string]json.RawMessage   `json:"functions"`
+		Profiles  []map[string]json.RawMessage `json:"profiles"`
+	}
+	if err := json.Unmarshal(js, &file); err != nil {
+		return nil, fmt.Errorf("cannot parse the profile file: %w", err)
+	}
+
+	if len(file.Profiles) == 0 {
+		return nil, fmt.Errorf("the profile file declares no profiles")
+	}
+
+	sets := make(map[string]*ProfileSet, len(file.Functions))
+	for name, raw := range file.Functions {
+		fn := &pb.FunctionConfig{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(raw), fn); err != nil {
+			return nil, fmt.Errorf("invalid function %s: %w", name, err)
+		}
+		if fn.GetHost() == "" || fn.GetPort() <= 0 || fn.GetPort() > 65535 {
+			return nil, fmt.Errorf("invalid function %s: host and port are required", name)
+		}
+		sets[name] = &ProfileSet{Function: fn}
+	}
+
+	names := make(map[string]bool, len(file.Profiles))
+	for i, fields := range file.Profiles {
+		function := DefaultFunction
+		if raw, ok := fields["function"]; ok {
+			if err := json.Unmarshal(raw, &function); err != nil {
+				return nil, fmt.Errorf("invalid function of profile %d: %w", i, err)
+			}
+			delete(fields, "function")
+		}
+
+		js, err := json.Marshal(fields)
+		if err != nil {
+			return nil, fmt.Errorf("invalid profile %d: %w", i, err)
+		}
+		p := &pb.MatchProfile{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(js), p); err != nil {
+			return nil, fmt.Errorf("invalid profile %d: %w", i, err)
+		}
+		if err := validateProfile(p); err != nil {
+			return nil, fmt.Errorf("invalid profile %d: %w", i, err)
+		}
+		if names[p.GetName()] {
+			return nil, fmt.Errorf("invalid profile %d: duplicate name %s", i, p.GetName())
+		}
+		names[p.GetName()] = true
+
+		set, ok := sets[function]
+		if !ok {
+			return nil, fmt.Errorf("invalid profile %s: unknown function %s", p.GetName(), function)
+		}
+		set.Profiles = append(set.Profiles, p)
+	}
+
+	functions := make([]string, 0, len(sets))
+	for name, set := range sets {
+		if len(set.Profiles) > 0 {
+			functions = append(functions, name)
+		}
+	}
+	sort.Strings(functions)
+
+	result := make([]*ProfileSet, 0, len(functions))
+	for _, name := range functions {
+		result = append(result, sets[name])
+	}
+	return result, nil
+}
+
+// LoadProfiles parses and validates the profile file at path.
+func LoadProfiles(path string) ([]*ProfileSet, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the profile file: %w", err)
+	}
+	return ParseProfiles(data)
+}
+
+func validateProfile(p *pb.MatchProfile) error {
+	if p.GetName() == "" {
+		return fmt.Errorf("name is required")
+	}
+	pools := make(map[string]bool, len(p.GetPools()))
+	for _, pool := range p.GetPools() {
+		if pool.GetName() == "" {
+			return fmt.Errorf("profile %s: pool name is required", p.GetName())
+		}
+		if pools[pool.GetName()] {
+			return fmt.Errorf("profile %s: duplicate pool %s", p.GetName(), pool.GetName())
+		}
+		pools[pool.GetName()] = true
+		if _, err := filter.NewPoolFilter(pool); err != nil {
+			return fmt.Errorf("profile %s: pool %s: %w", p.GetName(), pool.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// jsonValue converts the maps decoded by yaml, keyed by interface{}, to maps
+// keyed by string that can be encoded to JSON.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = jsonValue(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range v {
+			v[i] = jsonValue(e)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// ProfileFile is a ProfileSource reading a profile file, and reloading it
+// when it changes. A reload that fails to parse or validate is rejected and
+// the previous profiles stay active, as they do while the file is removed.
+type ProfileFile struct {
+	path    string
+	watcher *fsnotify.Watcher
+	done    chan struct{}
+
+	mu   sync.RWMutex
+	sets []*ProfileSet
+	err  error
+}
+
+// WatchProfiles loads the profile file at path and watches it for changes
+// until Close is called.
+func WatchProfiles(path string) (*ProfileFile, error) {
+	sets, err := LoadProfiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("cannot watch the profile file: %w", err)
+	}
+	// The directory is watched rather than the file, so that the file can be
+	// removed and created again, or replaced by the symlink swap of a
+	// Kubernetes ConfigMap.
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("cannot watch the profile file: %w", err)
+	}
+
+	f := &ProfileFile{
+		path:    filepath.Clean(path),
+		watcher: watcher,
+		done:    make(chan struct{}),
+		sets:    sets,
+	}
+	realPath, _ := filepath.EvalSymlinks(f.path)
+	go f.watch(realPath)
+	return f, nil
+}
+
+// watch reloads the file when it is written or created, or when the file it
+// links to changes, until the watcher is closed.
+func (f *ProfileFile) watch(realPath string) {
+	defer close(f.done)
+
+	for {
+		select {
+		case event, ok := <-f.watcher.Events:
+			if !ok {
+				return
+			}
+			current, _ := filepath.EvalSymlinks(f.path)
+			isFile := filepath.Clean(event.Name) == f.path
+			switch {
+			case isFile && event.Op&(fsnotify.Write|fsnotify.Create) != 0, current != "" && current != realPath:
+				realPath = current
+				logger.Infof("Profile file changed, operation: %v, filename: %s", event.Op, event.Name)
+				// The error is logged, and reported by Err.
+				_ = f.Reload()
+			case isFile && event.Op&(fsnotify.Remove|fsnotify.Rename) != 0:
+				realPath = ""
+				logger.Warningf("Profile file %s was removed, the profiles are kept until it is created again", f.path)
+			}
+		case err, ok := <-f.watcher.Errors:
+			if !ok {
+				return
+			}
+			logger.WithError(err).Error("Failed to watch the profile file")
+		}
+	}
+}
+
+// Close stops watching the profile file. The last accepted profiles stay
+// available.
+func (f *ProfileFile) Close() error {
+	err := f.watcher.Close()
+	<-f.done
+	return err
+}
+
+// Reload reads the profile file again, and returns the error if it is
+// rejected.
+func (f *ProfileFile) Reload() error {
+	sets, err := LoadProfiles(f.path)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+	if err != nil {
+		logger.WithError(err).Error("Profile file change rejected")
+		return err
+	}
+	f.sets = sets
+	return nil
+}
+
+// ProfileSets returns the profile sets of the last accepted version of the
+// file.
+func (f *ProfileFile) ProfileSets() []*ProfileSet {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.sets
+}
+
+// Err returns the error of the last reload, nil if it was accepted.
+func (f *ProfileFile) Err() error {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.err
+}
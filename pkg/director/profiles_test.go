@@ -0,0 +1,169 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package director
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const profilesYAML = `
+functions:
+  default:
+    host: om-function
+    port: 50502
+    type: GRPC
+  rest:
+    host: om-function-rest
+    port: 51502
+    type: REST
+profiles:
+- name: 1v1
+  pools:
+  - name: everyone
+    tagPresentFilters:
+    - tag: mode.1v1
+    doubleRangeFilters:
+    - doubleArg: skill
+      min: 0
+      max: 100
+  extensions:
+    team_size:
+      "@type": type.googleapis.com/google.protobuf.Int32Value
+      value: 1
+- name: ctf
+  function: rest
+  pools:
+  - name: ctf
+    stringEqualsFilters:
+    - stringArg: mode
+      value: ctf
+`
+
+const profilesJSON = `{
+  "functions": {"default": {"host": "om-function", "port": 50502, "type": "GRPC"}},
+  "profiles": [{"name": "1v1", "pools": [{"name": "everyone"}]}]
+}`
+
+func TestParseProfiles(t *testing.T) {
+	require := require.New(t)
+
+	sets, err := ParseProfiles([]byte(profilesYAML))
+	require.NoError(err)
+	require.Len(sets, 2)
+
+	require.Equal("om-function", sets[0].Function.GetHost())
+	require.Equal(pb.FunctionConfig_GRPC, sets[0].Function.GetType())
+	require.Len(sets[0].Profiles, 1)
+	p := sets[0].Profiles[0]
+	require.Equal("1v1", p.GetName())
+	require.Equal("mode.1v1", p.GetPools()[0].GetTagPresentFilters()[0].GetTag())
+	require.Equal(100.0, p.GetPools()[0].GetDoubleRangeFilters()[0].GetMax())
+
+	teamSize := &wrappers.Int32Value{}
+	require.NoError(ptypes.UnmarshalAny(p.GetExtensions()["team_size"], teamSize))
+	require.Equal(int32(1), teamSize.GetValue())
+
+	require.Equal(pb.FunctionConfig_REST, sets[1].Function.GetType())
+	require.Equal("ctf", sets[1].Profiles[0].GetName())
+	require.Equal("ctf", sets[1].Profiles[0].GetPools()[0].GetStringEqualsFilters()[0].GetValue())
+
+	sets, err = ParseProfiles([]byte(profilesJSON))
+	require.NoError(err)
+	require.Len(sets, 1)
+	require.Equal("everyone", sets[0].Profiles[0].GetPools()[0].GetName())
+}
+
+func TestParseProfilesInvalid(t *testing.T) {
+	const function = "functions: {default: {host: om-function, port: 50502}}\n"
+	for name, data := range map[string]string{
+		"not yaml":          "profiles: [",
+		"no profiles":       function,
+		"missing host":      "functions: {default: {port: 50502}}\nprofiles: [{name: a}]",
+		"unknown function":  function + "profiles: [{name: a, function: other}]",
+		"missing name":      function + "profiles: [{pools: [{name: a}]}]",
+		"duplicate profile": function + "profiles: [{name: a}, {name: a}]",
+		"duplicate pool":    function + "profiles: [{name: a, pools: [{name: p}, {name: p}]}]",
+		"unknown field":     function + "profiles: [{name: a, pool: []}]",
+		"invalid filter":    function + "profiles: [{name: a, pools: [{name: p, doubleRangeFilters: [{doubleArg: skill, relaxation: {limit: -1}}]}]}]",
+		"unknown extension": function + "profiles: [{name: a, extensions: {x: {'@type': type.googleapis.com/unknown.Type}}}]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseProfiles([]byte(data))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestProfileFileReload(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "profiles.yaml")
+	require.NoError(ioutil.WriteFile(path, []byte(profilesJSON), 0644))
+
+	f, err := WatchProfiles(path)
+	require.NoError(err)
+	defer f.Close()
+	require.Len(f.ProfileSets(), 1)
+
+	require.NoError(ioutil.WriteFile(path, []byte("profiles: ["), 0644))
+	require.Error(f.Reload())
+	require.Error(f.Err())
+	require.Len(f.ProfileSets(), 1)
+
+	require.NoError(ioutil.WriteFile(path, []byte(profilesYAML), 0644))
+	require.NoError(f.Reload())
+	require.Len(f.ProfileSets(), 2)
+}
+
+func TestWatchProfiles(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "profiles")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "profiles.yaml")
+	require.NoError(ioutil.WriteFile(path, []byte(profilesJSON), 0644))
+
+	f, err := WatchProfiles(path)
+	require.NoError(err)
+	defer f.Close()
+	require.Len(f.ProfileSets(), 1)
+
+	require.NoError(ioutil.WriteFile(path, []byte(profilesYAML), 0644))
+	require.Eventually(func() bool { return len(f.ProfileSets()) == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	// The profiles are kept while the file is removed, and reloaded once it
+	// is created again.
+	require.NoError(os.Remove(path))
+	require.NoError(ioutil.WriteFile(path, []byte(profilesJSON), 0644))
+	require.Eventually(func() bool { return len(f.ProfileSets()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(f.Close())
+	require.NoError(ioutil.WriteFile(path, []byte(profilesYAML), 0644))
+	time.Sleep(50 * time.Millisecond)
+	require.Len(f.ProfileSets(), 1)
+}
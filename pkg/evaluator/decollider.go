@@ -0,0 +1,181 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluator
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/stats"
+	internalEvaluator "open-match.dev/open-match/internal/app/evaluator"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// evaluationInputKey is the match extension holding the
+// DefaultEvaluationCriteria read by Score.
+const evaluationInputKey = "evaluation_input"
+
+var logger = logrus.WithFields(logrus.Fields{
+	"app":       "evaluator",
+	"component": "evaluator.default",
+})
+
+// Score returns the DefaultEvaluationCriteria.Score set in the
+// evaluation_input extension of m. The criteria are optional: matches without
+// them get a score of -Inf, so that they are sorted lower than any match
+// which provided them.
+func Score(m *pb.Match) (float64, error) {
+	a, ok := m.GetExtensions()[evaluationInputKey]
+	if !ok {
+		return math.Inf(-1), nil
+	}
+	inp := &pb.DefaultEvaluationCriteria{}
+	if err := ptypes.UnmarshalAny(a, inp); err != nil {
+		return 0, err
+	}
+	return inp.GetScore(), nil
+}
+
+type collidingMatch struct {
+	id    string
+	score float64
+}
+
+// Decollider accepts the matches given to it in order, unless they share a
+// ticket or a backfill with a match it accepted before. Custom evaluators
+// can use it after sorting the matches by their own criteria.
+type Decollider struct {
+	resultIDs     []string
+	ticketsUsed   map[string]*collidingMatch
+	backfillsUsed map[string]*collidingMatch
+}
+
+// NewDecollider returns a Decollider which accepted no match yet.
+func NewDecollider() *Decollider {
+	return &Decollider{
+		ticketsUsed:   make(map[string]*collidingMatch),
+		backfillsUsed: make(map[string]*collidingMatch),
+	}
+}
+
+// Add accepts m and returns true if none of its tickets and not its backfill
+// were used by a match accepted before. score is only used for logging.
+func (d *Decollider) Add(m *pb.Match, score float64) bool {
+	if m.Backfill != nil && m.Backfill.Id != "" {
+		if cm, ok := d.backfillsUsed[m.Backfill.Id]; ok {
+			logger.WithFields(logrus.Fields{
+				"match_id":              m.GetMatchId(),
+				"backfill_id":           m.Backfill.Id,
+				"match_score":           score,
+				"colliding_match_id":    cm.id,
+				"colliding_match_score": cm.score,
+			}).Info("Higher quality match with colliding backfill found. Rejecting match.")
+			return false
+		}
+	}
+
+	for _, t := range m.GetTickets() {
+		if cm, ok := d.ticketsUsed[t.Id]; ok {
+			logger.WithFields(logrus.Fields{
+				"match_id":              m.GetMatchId(),
+				"ticket_id":             t.GetId(),
+				"match_score":           score,
+				"colliding_match_id":    cm.id,
+				"colliding_match_score": cm.score,
+			}).Info("Higher quality match with colliding ticket found. Rejecting match.")
+			return false
+		}
+	}
+
+	if m.Backfill != nil && m.Backfill.Id != "" {
+		d.backfillsUsed[m.Backfill.Id] = &collidingMatch{
+			id:    m.GetMatchId(),
+			score: score,
+		}
+	}
+
+	for _, t := range m.GetTickets() {
+		d.ticketsUsed[t.Id] = &collidingMatch{
+			id:    m.GetMatchId(),
+			score: score,
+		}
+	}
+
+	d.resultIDs = append(d.resultIDs, m.GetMatchId())
+	return true
+}
+
+// MatchIDs returns the IDs of the accepted matches, in the order they were
+// added.
+func (d *Decollider) MatchIDs() []string {
+	return d.resultIDs
+}
+
+type scoredMatch struct {
+	match *pb.Match
+	score float64
+}
+
+// Decollide is the default Evaluator. It sorts the matches by
+// DefaultEvaluationCriteria.Score (optional), then returns matches which
+// don't collide with previously returned matches.
+func Decollide(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+	matches := make([]*scoredMatch, 0)
+	nilEvaluationInputs := 0
+
+	for m := range in {
+		if _, ok := m.GetExtensions()[evaluationInputKey]; !ok {
+			nilEvaluationInputs++
+		}
+		score, err := Score(m)
+		if err != nil {
+			logger.WithFields(logrus.Fields{
+				"match_id": m.MatchId,
+				"error":    err,
+			}).Error("Failed to unmarshal match's DefaultEvaluationCriteria.  Rejecting match.")
+			continue
+		}
+		matches = append(matches, &scoredMatch{
+			match: m,
+			score: score,
+		})
+	}
+
+	if nilEvaluationInputs > 0 {
+		logger.WithFields(logrus.Fields{
+			"count": nilEvaluationInputs,
+		}).Info("Some matches don't have the optional field evaluation_input set.")
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	d := NewDecollider()
+	for _, m := range matches {
+		d.Add(m.match, m.score)
+	}
+
+	stats.Record(ctx, internalEvaluator.CollidedMatchesPerEvaluate.M(int64(len(matches)-len(d.MatchIDs()))))
+
+	for _, id := range d.MatchIDs() {
+		out <- id
+	}
+
+	return nil
+}
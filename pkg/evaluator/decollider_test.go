@@ -0,0 +1,58 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestDecollider(t *testing.T) {
+	ticket1 := &pb.Ticket{Id: "1"}
+	ticket2 := &pb.Ticket{Id: "2"}
+	ticket3 := &pb.Ticket{Id: "3"}
+	backfill := &pb.Backfill{Id: "b"}
+
+	d := NewDecollider()
+	require.True(t, d.Add(&pb.Match{MatchId: "m1", Tickets: []*pb.Ticket{ticket1}}, 3))
+	require.False(t, d.Add(&pb.Match{MatchId: "m2", Tickets: []*pb.Ticket{ticket1, ticket2}}, 2))
+	require.True(t, d.Add(&pb.Match{MatchId: "m3", Tickets: []*pb.Ticket{ticket2}, Backfill: backfill}, 1))
+	require.False(t, d.Add(&pb.Match{MatchId: "m4", Tickets: []*pb.Ticket{ticket3}, Backfill: backfill}, 0))
+	require.True(t, d.Add(&pb.Match{MatchId: "m5", Tickets: []*pb.Ticket{ticket3}}, 0))
+
+	require.Equal(t, []string{"m1", "m3", "m5"}, d.MatchIDs())
+}
+
+func TestScore(t *testing.T) {
+	score, err := Score(&pb.Match{})
+	require.NoError(t, err)
+	require.True(t, math.IsInf(score, -1))
+
+	inp, err := ptypes.MarshalAny(&pb.DefaultEvaluationCriteria{Score: 12})
+	require.NoError(t, err)
+	score, err = Score(&pb.Match{Extensions: map[string]*any.Any{"evaluation_input": inp}})
+	require.NoError(t, err)
+	require.Equal(t, 12.0, score)
+
+	wrong, err := ptypes.MarshalAny(&pb.Ticket{})
+	require.NoError(t, err)
+	_, err = Score(&pb.Match{Extensions: map[string]*any.Any{"evaluation_input": wrong}})
+	require.Error(t, err)
+}
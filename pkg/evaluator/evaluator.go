@@ -0,0 +1,58 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package evaluator provides the building blocks to author an evaluator: the
+// server streaming the matches to evaluate, the application running it with
+// health checks and telemetry, and the default decollider.
+//
+// A custom evaluator only has to implement the Evaluator function:
+//
+//	func main() {
+//		evaluator.RunApplication(func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+//			...
+//		})
+//	}
+package evaluator
+
+import (
+	"context"
+
+	internalEvaluator "open-match.dev/open-match/internal/app/evaluator"
+	"open-match.dev/open-match/internal/appmain"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// Evaluator is the function signature for the Evaluator to be implemented by
+// the user. The harness will pass the Matches to evaluate to the Evaluator
+// and the Evaluator will return an accepted list of Matches.
+//
+// in is closed once all the matches of the call were received. The
+// Evaluator returns once it sent the IDs of the accepted matches to out,
+// which is closed by the harness.
+type Evaluator func(ctx context.Context, in <-chan *pb.Match, out chan<- string) error
+
+// NewServer returns a pb.EvaluatorServer streaming the matches of each
+// Evaluate call to eval, and the IDs of the matches it accepts back to the
+// synchronizer.
+func NewServer(eval Evaluator) pb.EvaluatorServer {
+	return internalEvaluator.NewServer(internalEvaluator.Evaluator(eval))
+}
+
+// RunApplication serves eval until the process is signaled to stop. The
+// configuration is read like for the Open Match core services, and the
+// evaluator listens on the api.evaluator ports. Health checks, metrics and
+// traces are set up according to the telemetry configuration.
+func RunApplication(eval Evaluator) {
+	appmain.RunApplication("evaluator", internalEvaluator.BindServiceFor(internalEvaluator.Evaluator(eval)))
+}
@@ -12,7 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package matchfunction provides helper methods to simplify authoring a match function.
+// Package matchfunction provides helper methods to simplify authoring a match function,
+// and a server running a MatchFunction given the tickets of the pools of the profile.
 package matchfunction
 
 import (
@@ -0,0 +1,212 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matchfunction
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/internal/appmain"
+	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/internal/telemetry"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+var (
+	logger = logrus.WithFields(logrus.Fields{
+		"app":       "matchfunction",
+		"component": "matchfunction.server",
+	})
+
+	runLatency      = stats.Float64("open-match.dev/matchfunction/run_latency", "Time spent by a Run call, querying the pools included", stats.UnitMilliseconds)
+	proposalsPerRun = stats.Int64("open-match.dev/matchfunction/proposals_per_run", "Number of proposals returned per Run call", stats.UnitDimensionless)
+
+	runLatencyView = &view.View{
+		Measure:     runLatency,
+		Name:        "open-match.dev/matchfunction/run_latency",
+		Description: "Time spent by a Run call, querying the pools included",
+		Aggregation: telemetry.DefaultMillisecondsDistribution,
+	}
+	proposalsPerRunView = &view.View{
+		Measure:     proposalsPerRun,
+		Name:        "open-match.dev/matchfunction/proposals_per_run",
+		Description: "Number of proposals returned per Run call",
+		Aggregation: telemetry.DefaultCountDistribution,
+	}
+)
+
+// MatchFunction is the function signature for the match function served by
+// NewServer. It is given the tickets and the backfills of the pools of
+// profile, keyed by pool name, and returns the proposals.
+type MatchFunction func(ctx context.Context, profile *pb.MatchProfile, pools map[string][]*pb.Ticket, backfillPools map[string][]*pb.Backfill) ([]*pb.Match, error)
+
+// Option configures the server returned by NewServer.
+type Option func(*matchFunctionService)
+
+// WithTimeout bounds the time spent by a Run call, querying the pools and
+// running the match function included. By default, only the deadline set by
+// the synchronizer applies.
+func WithTimeout(timeout time.Duration) Option {
+	return func(s *matchFunctionService) {
+		s.timeout = timeout
+	}
+}
+
+// WithoutBackfills skips querying the backfills, for the match functions
+// which don't make backfill matches. The match function is then given an
+// empty backfillPools.
+func WithoutBackfills() Option {
+	return func(s *matchFunctionService) {
+		s.skipBackfills = true
+	}
+}
+
+// matchFunctionService implements pb.MatchFunctionServer by querying the
+// pools of the profile and calling the match function.
+type matchFunctionService struct {
+	queryClient   pb.QueryServiceClient
+	mmf           MatchFunction
+	timeout       time.Duration
+	skipBackfills bool
+}
+
+// NewServer returns a pb.MatchFunctionServer which, for each Run call, queries
+// the tickets and backfills of the pools of the profile from queryClient,
+// calls mmf and streams the proposals back. A panic of mmf fails the call
+// instead of crashing the server.
+func NewServer(queryClient pb.QueryServiceClient, mmf MatchFunction, opts ...Option) pb.MatchFunctionServer {
+	s := &matchFunctionService{
+		queryClient: queryClient,
+		mmf:         mmf,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Run is this harness's implementation of the gRPC call defined in
+// api/matchfunction.proto.
+func (s *matchFunctionService) Run(req *pb.RunRequest, stream pb.MatchFunction_RunServer) error {
+	ctx := stream.Context()
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	proposals, err := s.run(ctx, req.GetProfile())
+	stats.Record(ctx, runLatency.M(float64(time.Since(start))/float64(time.Millisecond)))
+	if err != nil {
+		return err
+	}
+	stats.Record(ctx, proposalsPerRun.M(int64(len(proposals))))
+
+	for _, proposal := range proposals {
+		if err := stream.Send(&pb.RunResponse{Proposal: proposal}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *matchFunctionService) run(ctx context.Context, profile *pb.MatchProfile) ([]*pb.Match, error) {
+	pools, err := QueryPools(ctx, s.queryClient, profile.GetPools())
+	if err != nil {
+		return nil, queryError(ctx, profile, err)
+	}
+
+	backfillPools := make(map[string][]*pb.Backfill)
+	if !s.skipBackfills {
+		backfillPools, err = QueryBackfillPools(ctx, s.queryClient, profile.GetPools())
+		if err != nil {
+			return nil, queryError(ctx, profile, err)
+		}
+	}
+
+	proposals, err := s.call(ctx, profile, pools, backfillPools)
+	if err != nil {
+		return nil, err
+	}
+	for _, proposal := range proposals {
+		if proposal.GetMatchProfile() == "" {
+			proposal.MatchProfile = profile.GetName()
+		}
+	}
+	return proposals, nil
+}
+
+// call runs the match function, turning its panics into errors.
+func (s *matchFunctionService) call(ctx context.Context, profile *pb.MatchProfile, pools map[string][]*pb.Ticket, backfillPools map[string][]*pb.Backfill) (proposals []*pb.Match, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.WithFields(logrus.Fields{
+				"profile": profile.GetName(),
+				"panic":   r,
+			}).Error("match function panicked")
+			proposals, err = nil, status.Errorf(codes.Internal, "match function panicked for profile %s: %v", profile.GetName(), r)
+		}
+	}()
+	return s.mmf(ctx, profile, pools, backfillPools)
+}
+
+func queryError(ctx context.Context, profile *pb.MatchProfile, err error) error {
+	code := codes.Unavailable
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		code = codes.DeadlineExceeded
+	} else if errors.Is(ctx.Err(), context.Canceled) {
+		code = codes.Canceled
+	}
+	return status.Errorf(code, "cannot query the pools of profile %s: %v", profile.GetName(), err)
+}
+
+// bindService creates the match function service for mmf and binds it to the
+// serving harness. The tickets are queried from the QueryService configured
+// in api.query.
+func bindService(mmf MatchFunction, opts ...Option) appmain.Bind {
+	return func(p *appmain.Params, b *appmain.Bindings) error {
+		conn, err := rpc.GRPCClientFromConfig(p.Config(), "api.query")
+		if err != nil {
+			return err
+		}
+		b.AddCloserErr(conn.Close)
+
+		service := NewServer(pb.NewQueryServiceClient(conn), mmf, opts...)
+		b.AddHandleFunc(func(s *grpc.Server) {
+			pb.RegisterMatchFunctionServer(s, service)
+		}, pb.RegisterMatchFunctionHandlerFromEndpoint)
+		b.RegisterViews(
+			runLatencyView,
+			proposalsPerRunView,
+		)
+		return nil
+	}
+}
+
+// RunApplication serves mmf until the process is signaled to stop. The
+// configuration is read like for the Open Match core services, and the match
+// function listens on the api.function ports. Health checks, metrics and
+// traces are set up according to the telemetry configuration.
+func RunApplication(mmf MatchFunction, opts ...Option) {
+	appmain.RunApplication("function", bindService(mmf, opts...))
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matchfunction
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+type fakeQueryClient struct {
+	pb.QueryServiceClient
+	tickets   map[string][]*pb.Ticket
+	backfills map[string][]*pb.Backfill
+	err       error
+}
+
+func (c *fakeQueryClient) QueryTickets(ctx context.Context, in *pb.QueryTicketsRequest, opts ...grpc.CallOption) (pb.QueryService_QueryTicketsClient, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &fakeTicketsStream{resps: []*pb.QueryTicketsResponse{{Tickets: c.tickets[in.GetPool().GetName()]}}}, nil
+}
+
+func (c *fakeQueryClient) QueryBackfills(ctx context.Context, in *pb.QueryBackfillsRequest, opts ...grpc.CallOption) (pb.QueryService_QueryBackfillsClient, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &fakeBackfillsStream{resps: []*pb.QueryBackfillsResponse{{Backfills: c.backfills[in.GetPool().GetName()]}}}, nil
+}
+
+type fakeTicketsStream struct {
+	grpc.ClientStream
+	resps []*pb.QueryTicketsResponse
+}
+
+func (s *fakeTicketsStream) Recv() (*pb.QueryTicketsResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+	return resp, nil
+}
+
+type fakeBackfillsStream struct {
+	grpc.ClientStream
+	resps []*pb.QueryBackfillsResponse
+}
+
+func (s *fakeBackfillsStream) Recv() (*pb.QueryBackfillsResponse, error) {
+	if len(s.resps) == 0 {
+		return nil, io.EOF
+	}
+	resp := s.resps[0]
+	s.resps = s.resps[1:]
+	return resp, nil
+}
+
+type fakeRunStream struct {
+	grpc.ServerStream
+	sent []*pb.Match
+}
+
+func (s *fakeRunStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeRunStream) Send(resp *pb.RunResponse) error {
+	s.sent = append(s.sent, resp.GetProposal())
+	return nil
+}
+
+func TestServerRun(t *testing.T) {
+	query := &fakeQueryClient{
+		tickets:   map[string][]*pb.Ticket{"pool": {{Id: "1"}, {Id: "2"}}},
+		backfills: map[string][]*pb.Backfill{"pool": {{Id: "b"}}},
+	}
+	profile := &pb.MatchProfile{Name: "profile", Pools: []*pb.Pool{{Name: "pool"}}}
+
+	mmf := func(ctx context.Context, p *pb.MatchProfile, pools map[string][]*pb.Ticket, backfillPools map[string][]*pb.Backfill) ([]*pb.Match, error) {
+		require.Equal(t, profile, p)
+		require.Len(t, pools["pool"], 2)
+		require.Len(t, backfillPools["pool"], 1)
+		return []*pb.Match{{MatchId: "m", Tickets: pools["pool"], Backfill: backfillPools["pool"][0]}}, nil
+	}
+
+	stream := &fakeRunStream{}
+	require.NoError(t, NewServer(query, mmf).Run(&pb.RunRequest{Profile: profile}, stream))
+	require.Len(t, stream.sent, 1)
+	require.Equal(t, "m", stream.sent[0].GetMatchId())
+	require.Equal(t, "profile", stream.sent[0].GetMatchProfile())
+}
+
+func TestServerRunWithoutBackfills(t *testing.T) {
+	query := &fakeQueryClient{
+		tickets:   map[string][]*pb.Ticket{"pool": {{Id: "1"}}},
+		backfills: map[string][]*pb.Backfill{"pool": {{Id: "b"}}},
+	}
+
+	mmf := func(ctx context.Context, p *pb.MatchProfile, pools map[string][]*pb.Ticket, backfillPools map[string][]*pb.Backfill) ([]*pb.Match, error) {
+		require.Empty(t, backfillPools)
+		return nil, nil
+	}
+
+	stream := &fakeRunStream{}
+	req := &pb.RunRequest{Profile: &pb.MatchProfile{Name: "profile", Pools: []*pb.Pool{{Name: "pool"}}}}
+	require.NoError(t, NewServer(query, mmf, WithoutBackfills()).Run(req, stream))
+	require.Empty(t, stream.sent)
+}
+
+func TestServerRunPanic(t *testing.T) {
+	mmf := func(ctx context.Context, p *pb.MatchProfile, pools map[string][]*pb.Ticket, backfillPools map[string][]*pb.Backfill) ([]*pb.Match, error) {
+		panic("oops")
+	}
+
+	err := NewServer(&fakeQueryClient{}, mmf).Run(&pb.RunRequest{Profile: &pb.MatchProfile{Name: "profile"}}, &fakeRunStream{})
+	require.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestServerRunQueryError(t *testing.T) {
+	mmf := func(ctx context.Context, p *pb.MatchProfile, pools map[string][]*pb.Ticket, backfillPools map[string][]*pb.Backfill) ([]*pb.Match, error) {
+		t.Fatal("the match function must not be called")
+		return nil, nil
+	}
+
+	query := &fakeQueryClient{err: status.Error(codes.Unavailable, "query is down")}
+	req := &pb.RunRequest{Profile: &pb.MatchProfile{Name: "profile", Pools: []*pb.Pool{{Name: "pool"}}}}
+	err := NewServer(query, mmf).Run(req, &fakeRunStream{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,34 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memredis runs an in-memory Redis for omtest, so that tests don't
+// need a Redis server:
+//
+//	om, err := omtest.New(omtest.WithRedisServer(memredis.Start))
+package memredis
+
+import (
+	miniredis "github.com/alicebob/miniredis/v2"
+	"github.com/pkg/errors"
+	"open-match.dev/open-match/pkg/omtest"
+)
+
+// Start starts an in-memory Redis listening on a local port.
+func Start() (omtest.RedisServer, error) {
+	r := miniredis.NewMiniRedis()
+	if err := r.StartAddr("localhost:0"); err != nil {
+		return nil, errors.Wrap(err, "cannot start the in-memory redis")
+	}
+	return r, nil
+}
@@ -0,0 +1,555 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package omtest runs Open Match in-process, to integration test match
+// functions, evaluators and directors without a Kubernetes cluster.
+//
+// The frontend, backend, query and synchronizer services, the match function
+// and the evaluator are served on local ports. The tickets are stored in the
+// Redis given with WithRedis or WithRedisServer, such as the in-memory Redis
+// of the memredis package:
+//
+//	om, err := omtest.New(omtest.WithRedisServer(memredis.Start))
+//	...
+//	defer om.Close()
+//	om.SetMatchFunction(mmf)
+//	om.CreateTickets(ctx, tickets...)
+//	matches, err := om.FetchMatches(ctx, profile)
+package omtest
+
+import (
+	"context"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	internalEvaluator "open-match.dev/open-match/internal/app/evaluator"
+	"open-match.dev/open-match/internal/app/minimatch"
+	"open-match.dev/open-match/internal/appmain"
+	"open-match.dev/open-match/internal/clock"
+	"open-match.dev/open-match/internal/config"
+	"open-match.dev/open-match/internal/rpc"
+	"open-match.dev/open-match/pkg/evaluator"
+	"open-match.dev/open-match/pkg/matchfunction"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// serviceName is the name of the in-process application serving all the
+// services.
+const serviceName = "omtest"
+
+// defaultConfig is the configuration of the in-process Open Match, before the
+// options are applied. The intervals are short so that the tests run fast.
+const defaultConfig = `
+registrationInterval: 200ms
+proposalCollectionInterval: 200ms
+pendingReleaseTimeout: 1s
+assignedDeleteTimeout: 200ms
+queryPageSize: 1000
+backfillLockTimeout: 1m
+
+logging:
+  level: warning
+  format: text
+  rpc: false
+
+backoff:
+  initialInterval: 100ms
+  maxInterval: 500ms
+  multiplier: 1.5
+  randFactor: 0.5
+  maxElapsedTime: 3000ms
+
+redis:
+  usePassword: false
+  pool:
+    maxIdle: 200
+    maxActive: 0
+    idleTimeout: 0
+    healthCheckTimeout: 300ms
+
+telemetry:
+  reportingPeriod: "1m"
+  traceSamplingFraction: "0"
+  zpages:
+    enable: "false"
+  prometheus:
+    enable: "false"
+`
+
+// Option configures the Open Match started by New.
+type Option func(*options)
+
+type options struct {
+	config     map[string]interface{}
+	redisAddr  string
+	startRedis func() (RedisServer, error)
+	clock      Clock
+	mmf        matchfunction.MatchFunction
+	eval       evaluator.Evaluator
+}
+
+// WithConfig sets the configuration value of key, for example
+// "registrationInterval" or "pendingReleaseTimeout".
+func WithConfig(key string, value interface{}) Option {
+	return func(o *options) {
+		o.config[key] = value
+	}
+}
+
+// Clock is the clock used by the synchronizer and the state storage, for the
+// registration and proposal collection windows, the pending releases and the
+// backfill expirations.
+type Clock = clock.Clock
+
+// Timer is a function scheduled with Clock.AfterFunc.
+type Timer = clock.Timer
+
+// FakeClock is a Clock whose time only moves when it is advanced, see
+// OM.AdvanceTime.
+type FakeClock = clock.Fake
+
+// NewFakeClock returns a FakeClock starting at now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return clock.NewFake(now)
+}
+
+// WithClock runs Open Match with c instead of the wall clock. With a
+// FakeClock, the synchronizer cycles only progress when the clock is
+// advanced: FetchMatches returns once the registration window, which starts
+// when FetchMatches is called, is passed.
+func WithClock(c Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// WithRedis stores the tickets in the Redis listening on addr.
+func WithRedis(addr string) Option {
+	return func(o *options) {
+		o.redisAddr = addr
+	}
+}
+
+// RedisServer is a Redis started for an OM by WithRedisServer.
+type RedisServer interface {
+	// Addr returns the address the Redis listens on.
+	Addr() string
+	// FastForward expires the keys as if d passed.
+	FastForward(d time.Duration)
+	Close()
+}
+
+// WithRedisServer stores the tickets in the Redis returned by start, which is
+// fast-forwarded by AdvanceTime and closed by Close.
+func WithRedisServer(start func() (RedisServer, error)) Option {
+	return func(o *options) {
+		o.startRedis = start
+	}
+}
+
+// WithMatchFunction sets the match function called by FetchMatches, see
+// SetMatchFunction.
+func WithMatchFunction(mmf matchfunction.MatchFunction) Option {
+	return func(o *options) {
+		o.mmf = mmf
+	}
+}
+
+// WithEvaluator sets the evaluator called by the synchronizer, see
+// SetEvaluator.
+func WithEvaluator(eval evaluator.Evaluator) Option {
+	return func(o *options) {
+		o.eval = eval
+	}
+}
+
+// OM is an Open Match running in-process.
+type OM struct {
+	app   *appmain.App
+	redis RedisServer
+	clock Clock
+	conns []*grpc.ClientConn
+
+	host     string
+	grpcPort int
+	httpPort int
+
+	fe    pb.FrontendServiceClient
+	be    pb.BackendServiceClient
+	query pb.QueryServiceClient
+
+	mu   sync.Mutex
+	mmf  matchfunction.MatchFunction
+	eval evaluator.Evaluator
+}
+
+// New starts Open Match in-process. Close stops it.
+func New(opts ...Option) (*OM, error) {
+	o := &options{
+		config: make(map[string]interface{}),
+		clock:  clock.Real,
+		eval:   evaluator.Decollide,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	om := &OM{
+		host:  "localhost",
+		clock: o.clock,
+		mmf:   o.mmf,
+		eval:  o.eval,
+	}
+	if err := om.start(o); err != nil {
+		om.Close()
+		return nil, err
+	}
+	return om, nil
+}
+
+func (om *OM) start(o *options) error {
+	redisAddr := o.redisAddr
+	if o.startRedis != nil {
+		redis, err := o.startRedis()
+		if err != nil {
+			return errors.Wrap(err, "cannot start the redis")
+		}
+		om.redis = redis
+		redisAddr = redis.Addr()
+	}
+	if redisAddr == "" {
+		return errors.New("a redis is required, see WithRedis and WithRedisServer")
+	}
+	redisHost, redisPort, err := net.SplitHostPort(redisAddr)
+	if err != nil {
+		return errors.Wrapf(err, "invalid redis address %s", redisAddr)
+	}
+
+	grpcListener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return err
+	}
+	httpListener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		grpcListener.Close()
+		return err
+	}
+	om.grpcPort = grpcListener.Addr().(*net.TCPAddr).Port
+	om.httpPort = httpListener.Addr().(*net.TCPAddr).Port
+	listeners := map[int]net.Listener{
+		om.grpcPort: grpcListener,
+		om.httpPort: httpListener,
+	}
+	listen := func(network, address string) (net.Listener, error) {
+		_, p, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		port, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, err
+		}
+		l, ok := listeners[port]
+		if !ok {
+			return nil, errors.Errorf("no listener for %s", address)
+		}
+		delete(listeners, port)
+		return l, nil
+	}
+
+	cfg := viper.New()
+	cfg.SetConfigType("yaml")
+	if err := cfg.ReadConfig(strings.NewReader(defaultConfig)); err != nil {
+		return err
+	}
+	cfg.Set("redis.hostname", redisHost)
+	cfg.Set("redis.port", redisPort)
+	for _, name := range []string{serviceName, "synchronizer", "backend", "frontend", "query", "evaluator", "function"} {
+		cfg.Set("api."+name+".hostname", om.host)
+		cfg.Set("api."+name+".grpcport", om.grpcPort)
+		cfg.Set("api."+name+".httpport", om.httpPort)
+	}
+	for k, v := range o.config {
+		cfg.Set(k, v)
+	}
+
+	bind := func(p *appmain.Params, b *appmain.Bindings) error {
+		for _, bind := range []appmain.Bind{
+			minimatch.BindService,
+			om.bindMatchFunction,
+			internalEvaluator.BindServiceFor(om.evaluate),
+		} {
+			if err := bind(p, b); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	getCfg := func() (config.View, error) {
+		return cfg, nil
+	}
+	om.app, err = appmain.NewApplication(serviceName, bind, getCfg, listen, appmain.WithClock(o.clock))
+	if err != nil {
+		for _, l := range listeners {
+			l.Close()
+		}
+		return err
+	}
+
+	dial := func(service string) (*grpc.ClientConn, error) {
+		conn, err := rpc.GRPCClientFromConfig(cfg, "api."+service)
+		if err == nil {
+			om.conns = append(om.conns, conn)
+		}
+		return conn, err
+	}
+	feConn, err := dial("frontend")
+	if err != nil {
+		return err
+	}
+	beConn, err := dial("backend")
+	if err != nil {
+		return err
+	}
+	queryConn, err := dial("query")
+	if err != nil {
+		return err
+	}
+	om.fe = pb.NewFrontendServiceClient(feConn)
+	om.be = pb.NewBackendServiceClient(beConn)
+	om.query = pb.NewQueryServiceClient(queryConn)
+	return nil
+}
+
+// Close stops Open Match.
+func (om *OM) Close() error {
+	var errs []string
+	for _, conn := range om.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if om.app != nil {
+		if err := om.app.Stop(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if om.redis != nil {
+		om.redis.Close()
+	}
+	if len(errs) > 0 {
+		return errors.Errorf("cannot stop open match: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Frontend returns a client of the FrontendService.
+func (om *OM) Frontend() pb.FrontendServiceClient {
+	return om.fe
+}
+
+// Backend returns a client of the BackendService.
+func (om *OM) Backend() pb.BackendServiceClient {
+	return om.be
+}
+
+// Query returns a client of the QueryService.
+func (om *OM) Query() pb.QueryServiceClient {
+	return om.query
+}
+
+// FunctionConfig returns the configuration to give to FetchMatches to call the
+// match function set with SetMatchFunction.
+func (om *OM) FunctionConfig() *pb.FunctionConfig {
+	return &pb.FunctionConfig{
+		Host: om.host,
+		Port: int32(om.grpcPort),
+		Type: pb.FunctionConfig_GRPC,
+	}
+}
+
+// SetMatchFunction sets the match function called for the FetchMatches calls
+// made with FunctionConfig.
+func (om *OM) SetMatchFunction(mmf matchfunction.MatchFunction) {
+	om.mu.Lock()
+	defer om.mu.Unlock()
+	om.mmf = mmf
+}
+
+// SetEvaluator sets the evaluator called by the synchronizer. It defaults to
+// evaluator.Decollide.
+func (om *OM) SetEvaluator(eval evaluator.Evaluator) {
+	om.mu.Lock()
+	defer om.mu.Unlock()
+	om.eval = eval
+}
+
+// bindMatchFunction serves runMMF as the match function, querying the pools
+// from the QueryService of om.
+func (om *OM) bindMatchFunction(p *appmain.Params, b *appmain.Bindings) error {
+	conn, err := rpc.GRPCClientFromConfig(p.Config(), "api.query")
+	if err != nil {
+		return err
+	}
+	b.AddCloserErr(conn.Close)
+
+	service := matchfunction.NewServer(pb.NewQueryServiceClient(conn), om.runMMF)
+	b.AddHandleFunc(func(s *grpc.Server) {
+		pb.RegisterMatchFunctionServer(s, service)
+	}, pb.RegisterMatchFunctionHandlerFromEndpoint)
+	return nil
+}
+
+func (om *OM) runMMF(ctx context.Context, profile *pb.MatchProfile, pools map[string][]*pb.Ticket, backfillPools map[string][]*pb.Backfill) ([]*pb.Match, error) {
+	om.mu.Lock()
+	mmf := om.mmf
+	om.mu.Unlock()
+	if mmf == nil {
+		return nil, errors.New("match function called without being set")
+	}
+	return mmf(ctx, profile, pools, backfillPools)
+}
+
+func (om *OM) evaluate(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
+	om.mu.Lock()
+	eval := om.eval
+	om.mu.Unlock()
+	return eval(ctx, in, out)
+}
+
+// Clock returns the clock used by Open Match.
+func (om *OM) Clock() Clock {
+	return om.clock
+}
+
+// AdvanceTime moves the time forward by d. A FakeClock given with WithClock is
+// advanced, firing the synchronizer windows and expiring the pending
+// releases; with any other clock, AdvanceTime waits d. The Redis of
+// WithRedisServer is also fast-forwarded, expiring its keys, such as the
+// backfill locks.
+func (om *OM) AdvanceTime(d time.Duration) {
+	if fake, ok := om.clock.(*FakeClock); ok {
+		fake.Advance(d)
+	} else {
+		time.Sleep(d)
+	}
+	if om.redis != nil {
+		om.redis.FastForward(d)
+	}
+}
+
+// CreateTickets creates tickets with the FrontendService, and returns the
+// created tickets, with their ID set.
+func (om *OM) CreateTickets(ctx context.Context, tickets ...*pb.Ticket) ([]*pb.Ticket, error) {
+	created := make([]*pb.Ticket, 0, len(tickets))
+	for _, t := range tickets {
+		resp, err := om.fe.CreateTicket(ctx, &pb.CreateTicketRequest{Ticket: t})
+		if err != nil {
+			return created, errors.Wrap(err, "cannot create ticket")
+		}
+		created = append(created, resp)
+	}
+	return created, nil
+}
+
+// FetchMatches runs a synchronizer cycle calling the match function for
+// profile, and returns the proposals accepted by the evaluator.
+func (om *OM) FetchMatches(ctx context.Context, profile *pb.MatchProfile) ([]*pb.Match, error) {
+	stream, err := om.be.FetchMatches(ctx, &pb.FetchMatchesRequest{
+		Config:  om.FunctionConfig(),
+		Profile: profile,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot fetch matches")
+	}
+
+	var matches []*pb.Match
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return matches, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot fetch matches")
+		}
+		matches = append(matches, resp.GetMatch())
+	}
+}
+
+// AssignTickets assigns connection to the tickets of matches with the
+// BackendService.
+func (om *OM) AssignTickets(ctx context.Context, connection string, matches ...*pb.Match) error {
+	var ids []string
+	for _, m := range matches {
+		for _, t := range m.GetTickets() {
+			ids = append(ids, t.GetId())
+		}
+	}
+	resp, err := om.be.AssignTickets(ctx, &pb.AssignTicketsRequest{
+		Assignments: []*pb.AssignmentGroup{{
+			TicketIds:  ids,
+			Assignment: &pb.Assignment{Connection: connection},
+		}},
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot assign tickets")
+	}
+	if len(resp.GetFailures()) > 0 {
+		return errors.Errorf("cannot assign %d tickets, first failure: %s %s", len(resp.GetFailures()), resp.GetFailures()[0].GetTicketId(), resp.GetFailures()[0].GetCause())
+	}
+	return nil
+}
+
+// Assignment returns the assignment of the ticket, nil if it isn't assigned.
+func (om *OM) Assignment(ctx context.Context, ticketID string) (*pb.Assignment, error) {
+	t, err := om.fe.GetTicket(ctx, &pb.GetTicketRequest{TicketId: ticketID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get ticket %s", ticketID)
+	}
+	return t.GetAssignment(), nil
+}
+
+// TestingT is the subset of testing.TB used by the assertions.
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+// AssertAssigned reports an error to t unless the ticket is assigned to
+// connection, and returns whether it is.
+func (om *OM) AssertAssigned(t TestingT, ticketID string, connection string) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	a, err := om.Assignment(context.Background(), ticketID)
+	if err != nil {
+		t.Errorf("%v", err)
+		return false
+	}
+	if a == nil {
+		t.Errorf("ticket %s is not assigned, want connection %q", ticketID, connection)
+		return false
+	}
+	if a.GetConnection() != connection {
+		t.Errorf("ticket %s is assigned to connection %q, want %q", ticketID, a.GetConnection(), connection)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package omtest_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/pkg/matchfunction"
+	"open-match.dev/open-match/pkg/omtest"
+	"open-match.dev/open-match/pkg/omtest/memredis"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+// pairs makes matches of two tickets of the pool.
+func pairs(ctx context.Context, profile *pb.MatchProfile, pools map[string][]*pb.Ticket, backfillPools map[string][]*pb.Backfill) ([]*pb.Match, error) {
+	var matches []*pb.Match
+	tickets := pools["all"]
+	for i := 0; i+1 < len(tickets); i += 2 {
+		matches = append(matches, &pb.Match{
+			MatchId:       fmt.Sprintf("%s-%d", profile.GetName(), i/2),
+			MatchFunction: "pairs",
+			Tickets:       tickets[i : i+2],
+		})
+	}
+	return matches, nil
+}
+
+func TestFetchAndAssign(t *testing.T) {
+	ctx := context.Background()
+	om, err := omtest.New(omtest.WithRedisServer(memredis.Start), omtest.WithMatchFunction(pairs))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, om.Close())
+	}()
+
+	tickets, err := om.CreateTickets(ctx, &pb.Ticket{}, &pb.Ticket{}, &pb.Ticket{})
+	require.NoError(t, err)
+	require.Len(t, tickets, 3)
+
+	profile := &pb.MatchProfile{Name: "profile", Pools: []*pb.Pool{{Name: "all"}}}
+	matches, err := om.FetchMatches(ctx, profile)
+	require.NoError(t, err)
+	require.Len(t, matches, 1)
+	require.Len(t, matches[0].GetTickets(), 2)
+
+	require.NoError(t, om.AssignTickets(ctx, "1.2.3.4:5678", matches...))
+	for _, ticket := range matches[0].GetTickets() {
+		require.True(t, om.AssertAssigned(t, ticket.GetId(), "1.2.3.4:5678"))
+	}
+}
+
+func TestFetchMatchesWithoutMatchFunction(t *testing.T) {
+	om, err := omtest.New(omtest.WithRedisServer(memredis.Start))
+	require.NoError(t, err)
+	defer om.Close()
+
+	_, err = om.FetchMatches(context.Background(), &pb.MatchProfile{Name: "profile"})
+	require.Error(t, err)
+}
+
+func TestRedisRequired(t *testing.T) {
+	_, err := omtest.New()
+	require.Error(t, err)
+}
+
+func TestFakeClock(t *testing.T) {
+	ctx := context.Background()
+	fake := omtest.NewFakeClock(time.Now())
+	om, err := omtest.New(omtest.WithRedisServer(memredis.Start), omtest.WithClock(fake), omtest.WithMatchFunction(pairs))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, om.Close())
+	}()
+
+	_, err = om.CreateTickets(ctx, &pb.Ticket{}, &pb.Ticket{})
+	require.NoError(t, err)
+
+	profile := &pb.MatchProfile{Name: "profile", Pools: []*pb.Pool{{Name: "all"}}}
+	type result struct {
+		matches []*pb.Match
+		err     error
+	}
+	results := make(chan result, 1)
+	go func() {
+		matches, err := om.FetchMatches(ctx, profile)
+		results <- result{matches, err}
+	}()
+
+	// The cycle waits for its registration window to pass on the fake clock.
+	fake.BlockUntil(1)
+	om.AdvanceTime(200 * time.Millisecond)
+	r := <-results
+	require.NoError(t, r.err)
+	require.Len(t, r.matches, 1)
+
+	// The matched tickets are pending release until the timeout passes on the
+	// fake clock.
+	tickets, err := matchfunction.QueryPool(ctx, om.Query(), profile.Pools[0])
+	require.NoError(t, err)
+	require.Empty(t, tickets)
+
+	om.AdvanceTime(2 * time.Second)
+	tickets, err = matchfunction.QueryPool(ctx, om.Query(), profile.Pools[0])
+	require.NoError(t, err)
+	require.Len(t, tickets, 2)
+}
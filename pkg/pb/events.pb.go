@@ -0,0 +1,542 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0-devel
+// 	protoc        v3.10.1
+// source: api/events.proto
+
+package pb
+
+import (
+	context "context"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TicketEvent_Type int32
+
+const (
+	// Unspecified event type.
+	TicketEvent_UNKNOWN TicketEvent_Type = 0
+	// The Ticket was created by the frontend.
+	TicketEvent_CREATED TicketEvent_Type = 1
+	// The search_fields or extensions of the Ticket were updated.
+	TicketEvent_UPDATED TicketEvent_Type = 2
+	// The Ticket was part of a match returned by FetchMatches, and is pending release.
+	TicketEvent_PROPOSED TicketEvent_Type = 3
+	// The Ticket was released from the pending state by the backend.
+	TicketEvent_RELEASED TicketEvent_Type = 4
+	// The pending release of the Ticket timed out, it is active again.
+	TicketEvent_EXPIRED TicketEvent_Type = 5
+	// An Assignment was set on the Ticket.
+	TicketEvent_ASSIGNED TicketEvent_Type = 6
+	// The client acknowledged the Assignment of the Ticket.
+	TicketEvent_ASSIGNMENT_ACKNOWLEDGED TicketEvent_Type = 7
+	// The Assignment of the Ticket was cleared and the Ticket is active again.
+	TicketEvent_REQUEUED TicketEvent_Type = 8
+	// The Ticket was deleted by the frontend, or removed when its time to live
+	// elapsed, such as an assigned Ticket after assignedDeleteTimeout.
+	TicketEvent_DELETED TicketEvent_Type = 9
+)
+
+// Enum value maps for TicketEvent_Type.
+var (
+	TicketEvent_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "PROPOSED",
+		4: "RELEASED",
+		5: "EXPIRED",
+		6: "ASSIGNED",
+		7: "ASSIGNMENT_ACKNOWLEDGED",
+		8: "REQUEUED",
+		9: "DELETED",
+	}
+	TicketEvent_Type_value = map[string]int32{
+		"UNKNOWN":                 0,
+		"CREATED":                 1,
+		"UPDATED":                 2,
+		"PROPOSED":                3,
+		"RELEASED":                4,
+		"EXPIRED":                 5,
+		"ASSIGNED":                6,
+		"ASSIGNMENT_ACKNOWLEDGED": 7,
+		"REQUEUED":                8,
+		"DELETED":                 9,
+	}
+)
+
+func (x TicketEvent_Type) Enum() *TicketEvent_Type {
+	p := new(TicketEvent_Type)
+	*p = x
+	return p
+}
+
+func (x TicketEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TicketEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_events_proto_enumTypes[0].Descriptor()
+}
+
+func (TicketEvent_Type) Type() protoreflect.EnumType {
+	return &file_api_events_proto_enumTypes[0]
+}
+
+func (x TicketEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TicketEvent_Type.Descriptor instead.
+func (TicketEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_api_events_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// A TicketEvent describes a change in the lifecycle of a Ticket. TicketEvents
+// are published by Open Match to the sinks configured in the events section of
+// the Open Match configuration.
+// BETA FEATURE WARNING: This message is not finalized and still subject to
+// possible change or removal.
+type TicketEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A unique id of the event, to be used by the sinks to deduplicate retried deliveries.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The type of the event.
+	Type TicketEvent_Type `protobuf:"varint,2,opt,name=type,proto3,enum=openmatch.TicketEvent_Type" json:"type,omitempty"`
+	// The id of the Ticket the event is about.
+	TicketId string `protobuf:"bytes,3,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	// The Ticket at the time of the event. It is only populated when the Ticket
+	// was read or written by the operation which triggered the event.
+	Ticket *Ticket `protobuf:"bytes,4,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	// The id of the match the Ticket was proposed in, for PROPOSED events.
+	MatchId string `protobuf:"bytes,5,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// The time at which the event happened.
+	EventTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+}
+
+func (x *TicketEvent) Reset() {
+	*x = TicketEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TicketEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketEvent) ProtoMessage() {}
+
+func (x *TicketEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketEvent.ProtoReflect.Descriptor instead.
+func (*TicketEvent) Descriptor() ([]byte, []int) {
+	return file_api_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TicketEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetType() TicketEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return TicketEvent_UNKNOWN
+}
+
+func (x *TicketEvent) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *TicketEvent) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *TicketEvent) GetEventTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+type PublishTicketEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TicketEvents in the order they were published.
+	Events []*TicketEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *PublishTicketEventsRequest) Reset() {
+	*x = PublishTicketEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishTicketEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTicketEventsRequest) ProtoMessage() {}
+
+func (x *PublishTicketEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTicketEventsRequest.ProtoReflect.Descriptor instead.
+func (*PublishTicketEventsRequest) Descriptor() ([]byte, []int) {
+	return file_api_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PublishTicketEventsRequest) GetEvents() []*TicketEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type PublishTicketEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PublishTicketEventsResponse) Reset() {
+	*x = PublishTicketEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishTicketEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishTicketEventsResponse) ProtoMessage() {}
+
+func (x *PublishTicketEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishTicketEventsResponse.ProtoReflect.Descriptor instead.
+func (*PublishTicketEventsResponse) Descriptor() ([]byte, []int) {
+	return file_api_events_proto_rawDescGZIP(), []int{2}
+}
+
+var File_api_events_proto protoreflect.FileDescriptor
+
+var file_api_events_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x12, 0x61,
+	0x70, 0x69, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x8b, 0x03, 0x0a, 0x0b, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x29, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x54, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x9c, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a,
+	0x07, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x53,
+	0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x53, 0x53, 0x49,
+	0x47, 0x4e, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x4b, 0x4e, 0x4f, 0x57, 0x4c, 0x45, 0x44,
+	0x47, 0x45, 0x44, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x51, 0x55, 0x45, 0x55, 0x45,
+	0x44, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x09,
+	0x22, 0x4c, 0x0a, 0x1a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1d,
+	0x0a, 0x1b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x71, 0x0a,
+	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x6e, 0x6b, 0x12, 0x64, 0x0a, 0x13, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x2e, 0x5a, 0x20, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x64,
+	0x65, 0x76, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x62, 0xaa, 0x02, 0x09, 0x4f, 0x70, 0x65, 0x6e, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_events_proto_rawDescOnce sync.Once
+	file_api_events_proto_rawDescData = file_api_events_proto_rawDesc
+)
+
+func file_api_events_proto_rawDescGZIP() []byte {
+	file_api_events_proto_rawDescOnce.Do(func() {
+		file_api_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_events_proto_rawDescData)
+	})
+	return file_api_events_proto_rawDescData
+}
+
+var file_api_events_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_events_proto_goTypes = []interface{}{
+	(TicketEvent_Type)(0),               // 0: openmatch.TicketEvent.Type
+	(*TicketEvent)(nil),                 // 1: openmatch.TicketEvent
+	(*PublishTicketEventsRequest)(nil),  // 2: openmatch.PublishTicketEventsRequest
+	(*PublishTicketEventsResponse)(nil), // 3: openmatch.PublishTicketEventsResponse
+	(*Ticket)(nil),                      // 4: openmatch.Ticket
+	(*timestamp.Timestamp)(nil),         // 5: google.protobuf.Timestamp
+}
+var file_api_events_proto_depIdxs = []int32{
+	0, // 0: openmatch.TicketEvent.type:type_name -> openmatch.TicketEvent.Type
+	4, // 1: openmatch.TicketEvent.ticket:type_name -> openmatch.Ticket
+	5, // 2: openmatch.TicketEvent.event_time:type_name -> google.protobuf.Timestamp
+	1, // 3: openmatch.PublishTicketEventsRequest.events:type_name -> openmatch.TicketEvent
+	2, // 4: openmatch.EventSink.PublishTicketEvents:input_type -> openmatch.PublishTicketEventsRequest
+	3, // 5: openmatch.EventSink.PublishTicketEvents:output_type -> openmatch.PublishTicketEventsResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_events_proto_init() }
+func file_api_events_proto_init() {
+	if File_api_events_proto != nil {
+		return
+	}
+	file_api_messages_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_api_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TicketEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishTicketEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishTicketEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_events_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_events_proto_goTypes,
+		DependencyIndexes: file_api_events_proto_depIdxs,
+		EnumInfos:         file_api_events_proto_enumTypes,
+		MessageInfos:      file_api_events_proto_msgTypes,
+	}.Build()
+	File_api_events_proto = out.File
+	file_api_events_proto_rawDesc = nil
+	file_api_events_proto_goTypes = nil
+	file_api_events_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// EventSinkClient is the client API for EventSink service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventSinkClient interface {
+	// PublishTicketEvents delivers a batch of TicketEvents to the sink.
+	PublishTicketEvents(ctx context.Context, in *PublishTicketEventsRequest, opts ...grpc.CallOption) (*PublishTicketEventsResponse, error)
+}
+
+type eventSinkClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventSinkClient(cc grpc.ClientConnInterface) EventSinkClient {
+	return &eventSinkClient{cc}
+}
+
+func (c *eventSinkClient) PublishTicketEvents(ctx context.Context, in *PublishTicketEventsRequest, opts ...grpc.CallOption) (*PublishTicketEventsResponse, error) {
+	out := new(PublishTicketEventsResponse)
+	err := c.cc.Invoke(ctx, "/openmatch.EventSink/PublishTicketEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventSinkServer is the server API for EventSink service.
+type EventSinkServer interface {
+	// PublishTicketEvents delivers a batch of TicketEvents to the sink.
+	PublishTicketEvents(context.Context, *PublishTicketEventsRequest) (*PublishTicketEventsResponse, error)
+}
+
+// UnimplementedEventSinkServer can be embedded to have forward compatible implementations.
+type UnimplementedEventSinkServer struct {
+}
+
+func (*UnimplementedEventSinkServer) PublishTicketEvents(context.Context, *PublishTicketEventsRequest) (*PublishTicketEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTicketEvents not implemented")
+}
+
+func RegisterEventSinkServer(s *grpc.Server, srv EventSinkServer) {
+	s.RegisterService(&_EventSink_serviceDesc, srv)
+}
+
+func _EventSink_PublishTicketEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTicketEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventSinkServer).PublishTicketEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openmatch.EventSink/PublishTicketEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventSinkServer).PublishTicketEvents(ctx, req.(*PublishTicketEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventSink_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "openmatch.EventSink",
+	HandlerType: (*EventSinkServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublishTicketEvents",
+			Handler:    _EventSink_PublishTicketEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/events.proto",
+}
@@ -0,0 +1,232 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package internal exports the state of Open Match to a file, and imports it
+// back, through the AdminService.
+//
+// The file is in JSON lines: a header line identifying the format and its
+// version, then one ExportStateResponse in the JSON mapping of protobuf per
+// line.
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+const (
+	// Format identifies the state files in their header.
+	Format = "open-match-state"
+	// Version is the version of the state files written. Files of other
+	// versions are rejected.
+	Version = 1
+
+	// maxLineSize bounds the size of a record, which is mostly the size of
+	// the extensions of its Ticket or Backfill.
+	maxLineSize = 64 * 1024 * 1024
+)
+
+// Header is the first line of a state file.
+type Header struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exportedAt"`
+}
+
+// Writer writes a state file.
+type Writer struct {
+	w *bufio.Writer
+	m jsonpb.Marshaler
+}
+
+// NewWriter writes the header of a state file exported at exportedAt to w.
+func NewWriter(w io.Writer, exportedAt time.Time) (*Writer, error) {
+	sw := &Writer{w: bufio.NewWriter(w)}
+	header, err := json.Marshal(&Header{Format: Format, Version: Version, ExportedAt: exportedAt.UTC()})
+	if err != nil {
+		return nil, err
+	}
+	if err := sw.writeLine(header); err != nil {
+		return nil, err
+	}
+	return sw, nil
+}
+
+// Write writes a record.
+func (sw *Writer) Write(r *pb.ExportStateResponse) error {
+	var buf bytes.Buffer
+	if err := sw.m.Marshal(&buf, r); err != nil {
+		return err
+	}
+	return sw.writeLine(buf.Bytes())
+}
+
+// Flush writes the buffered records.
+func (sw *Writer) Flush() error {
+	return sw.w.Flush()
+}
+
+func (sw *Writer) writeLine(line []byte) error {
+	if _, err := sw.w.Write(line); err != nil {
+		return err
+	}
+	return sw.w.WriteByte('\n')
+}
+
+// Reader reads a state file.
+type Reader struct {
+	s      *bufio.Scanner
+	header Header
+	line   int
+}
+
+// NewReader reads the header of the state file r, and fails if it is not a
+// state file of a supported version.
+func NewReader(r io.Reader) (*Reader, error) {
+	sr := &Reader{s: bufio.NewScanner(r)}
+	sr.s.Buffer(nil, maxLineSize)
+
+	if !sr.s.Scan() {
+		if err := sr.s.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("empty state file")
+	}
+	sr.line++
+	if err := json.Unmarshal(sr.s.Bytes(), &sr.header); err != nil {
+		return nil, fmt.Errorf("invalid state file header: %w", err)
+	}
+	if sr.header.Format != Format {
+		return nil, fmt.Errorf("not a state file, format %q", sr.header.Format)
+	}
+	if sr.header.Version != Version {
+		return nil, fmt.Errorf("unsupported state file version %d, want %d", sr.header.Version, Version)
+	}
+	return sr, nil
+}
+
+// Header returns the header of the state file.
+func (sr *Reader) Header() Header {
+	return sr.header
+}
+
+// Read returns the next record, or io.EOF at the end of the file.
+func (sr *Reader) Read() (*pb.ExportStateResponse, error) {
+	for sr.s.Scan() {
+		sr.line++
+		line := bytes.TrimSpace(sr.s.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		r := &pb.ExportStateResponse{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(line), r); err != nil {
+			return nil, fmt.Errorf("invalid record on line %d: %w", sr.line, err)
+		}
+		return r, nil
+	}
+	if err := sr.s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
+
+// Export writes the state exported by client to w, and returns the number of
+// records written.
+func Export(ctx context.Context, client pb.AdminServiceClient, w io.Writer) (int, error) {
+	sw, err := NewWriter(w, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	stream, err := client.ExportState(ctx, &pb.ExportStateRequest{})
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return n, err
+		}
+		if err := sw.Write(r); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, sw.Flush()
+}
+
+// Import imports the state file r through client, applying policy to the
+// Tickets and Backfills which already exist.
+func Import(ctx context.Context, client pb.AdminServiceClient, r io.Reader, policy pb.ImportStateRequest_ConflictPolicy) (*pb.ImportStateResponse, error) {
+	sr, err := NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.ImportState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		record, err := sr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		req := &pb.ImportStateRequest{ConflictPolicy: policy}
+		switch rec := record.GetRecord().(type) {
+		case *pb.ExportStateResponse_Ticket:
+			req.Record = &pb.ImportStateRequest_Ticket{Ticket: rec.Ticket}
+		case *pb.ExportStateResponse_Backfill:
+			req.Record = &pb.ImportStateRequest_Backfill{Backfill: rec.Backfill}
+		default:
+			continue
+		}
+		if err := stream.Send(req); err != nil {
+			// The server closed the stream, its error is returned by CloseAndRecv.
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// ParseConflictPolicy parses the name of a conflict policy, e.g. "skip".
+func ParseConflictPolicy(name string) (pb.ImportStateRequest_ConflictPolicy, error) {
+	v, ok := pb.ImportStateRequest_ConflictPolicy_value[strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("unknown conflict policy %q, must be one of skip, overwrite, fail", name)
+	}
+	return pb.ImportStateRequest_ConflictPolicy(v), nil
+}
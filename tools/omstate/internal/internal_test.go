@@ -0,0 +1,95 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"open-match.dev/open-match/pkg/pb"
+)
+
+func TestStateFileRoundTrip(t *testing.T) {
+	require := require.New(t)
+	exportedAt := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, exportedAt)
+	require.NoError(err)
+	require.NoError(w.Write(&pb.ExportStateResponse{Record: &pb.ExportStateResponse_Backfill{Backfill: &pb.BackfillRecord{
+		Backfill:  &pb.Backfill{Id: "b1", Generation: 2},
+		TicketIds: []string{"t1"},
+		Indexed:   true,
+	}}}))
+	require.NoError(w.Write(&pb.ExportStateResponse{Record: &pb.ExportStateResponse_Ticket{Ticket: &pb.TicketRecord{
+		Ticket:  &pb.Ticket{Id: "t1"},
+		Indexed: true,
+	}}}))
+	require.NoError(w.Flush())
+	require.Equal(3, strings.Count(buf.String(), "\n"))
+
+	r, err := NewReader(&buf)
+	require.NoError(err)
+	require.Equal(Version, r.Header().Version)
+	require.True(exportedAt.Equal(r.Header().ExportedAt))
+
+	record, err := r.Read()
+	require.NoError(err)
+	require.Equal("b1", record.GetBackfill().GetBackfill().GetId())
+	require.Equal([]string{"t1"}, record.GetBackfill().GetTicketIds())
+
+	record, err = r.Read()
+	require.NoError(err)
+	require.Equal("t1", record.GetTicket().GetTicket().GetId())
+	require.True(record.GetTicket().GetIndexed())
+
+	_, err = r.Read()
+	require.Equal(io.EOF, err)
+}
+
+func TestNewReaderInvalid(t *testing.T) {
+	for name, data := range map[string]string{
+		"empty":           "",
+		"not json":        "open-match-state\n",
+		"other format":    `{"format":"other","version":1}` + "\n",
+		"unknown version": `{"format":"open-match-state","version":2}` + "\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewReader(strings.NewReader(data))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestReadInvalidRecord(t *testing.T) {
+	r, err := NewReader(strings.NewReader(`{"format":"open-match-state","version":1}` + "\n{\"unknown\":1}\n"))
+	require.NoError(t, err)
+	_, err = r.Read()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "line 2")
+}
+
+func TestParseConflictPolicy(t *testing.T) {
+	p, err := ParseConflictPolicy("overwrite")
+	require.NoError(t, err)
+	require.Equal(t, pb.ImportStateRequest_OVERWRITE, p)
+
+	_, err = ParseConflictPolicy("merge")
+	require.Error(t, err)
+}
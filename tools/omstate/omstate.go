@@ -0,0 +1,128 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// omstate exports the Tickets and Backfills of an Open Match deployment to a
+// file, and imports them into another one, for migrations and disaster
+// recovery:
+//
+//	omstate export -addr om-backend:50505 -out state.jsonl
+//	omstate import -addr om-backend:50505 -in state.jsonl -conflict skip
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"open-match.dev/open-match/pkg/pb"
+	omstateInternal "open-match.dev/open-match/tools/omstate/internal"
+)
+
+var (
+	addrFlag     = flag.String("addr", "om-backend:50505", "Address of the Open Match backend serving the AdminService.")
+	caCertFlag   = flag.String("ca_cert", "", "CA certificate file of the backend, when it serves TLS.")
+	tokenFlag    = flag.String("token", "", "Bearer token of a caller with the permissions of the AdminService.")
+	outFlag      = flag.String("out", "-", "File the state is exported to, - for stdout.")
+	inFlag       = flag.String("in", "-", "File the state is imported from, - for stdin.")
+	conflictFlag = flag.String("conflict", "skip", "What to do with the Tickets and Backfills which already exist: skip, overwrite or fail.")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] export|import\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	conn, err := dial()
+	if err != nil {
+		log.Fatalf("cannot connect to %s, %s", *addrFlag, err)
+	}
+	defer conn.Close()
+	client := pb.NewAdminServiceClient(conn)
+	ctx := context.Background()
+
+	switch flag.Arg(0) {
+	case "export":
+		out := os.Stdout
+		if *outFlag != "-" {
+			if out, err = os.Create(*outFlag); err != nil {
+				log.Fatal(err)
+			}
+		}
+		n, err := omstateInternal.Export(ctx, client, out)
+		if err != nil {
+			log.Fatalf("export failed after %d records, %s", n, err)
+		}
+		if err := out.Close(); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("exported %d records", n)
+	case "import":
+		policy, err := omstateInternal.ParseConflictPolicy(*conflictFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		in := os.Stdin
+		if *inFlag != "-" {
+			if in, err = os.Open(*inFlag); err != nil {
+				log.Fatal(err)
+			}
+			defer in.Close()
+		}
+		resp, err := omstateInternal.Import(ctx, client, in, policy)
+		if err != nil {
+			log.Fatalf("import failed, %s", err)
+		}
+		log.Printf("imported %d tickets and %d backfills, skipped %d: %v",
+			resp.GetTicketsImported(), resp.GetBackfillsImported(), len(resp.GetSkippedIds()), resp.GetSkippedIds())
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func dial() (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if *caCertFlag != "" {
+		creds, err := credentials.NewClientTLSFromFile(*caCertFlag, "")
+		if err != nil {
+			return nil, err
+		}
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	}
+	if *tokenFlag != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(bearerToken(*tokenFlag)))
+	}
+	return grpc.Dial(*addrFlag, opts...)
+}
+
+// bearerToken sends a bearer token in the authorization metadata.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
@@ -15,14 +15,15 @@
 package evaluate
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"net"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"open-match.dev/open-match/pkg/evaluator"
 	"open-match.dev/open-match/pkg/pb"
 )
 
@@ -33,16 +34,12 @@ var (
 	})
 )
 
-// EvaluatorService implements pb.EvaluatorServer, the server generated by
-// compiling the protobuf, by fulfilling the pb.EvaluatorServer interface.
-type EvaluatorService struct {
-}
-
-// Start creates and starts the Evaluator server.
+// Start creates and starts the Evaluator server. The streaming of the
+// matches is handled by the Open Match evaluator package, which calls
+// Evaluate for each evaluation.
 func Start(port int) error {
-	evalService := EvaluatorService{}
 	server := grpc.NewServer()
-	pb.RegisterEvaluatorServer(server, &evalService)
+	pb.RegisterEvaluatorServer(server, evaluator.NewServer(Evaluate))
 	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		logger.WithFields(logrus.Fields{
@@ -67,18 +64,12 @@ func Start(port int) error {
 	return nil
 }
 
-// Evaluate is the implementation of the gRPC call defined in api/evaluator.proto.
-func (s *EvaluatorService) Evaluate(stream pb.Evaluator_EvaluateServer) error {
+// Evaluate collects the proposals of an evaluation and sends back the IDs of
+// the matches accepted by evaluate.
+func Evaluate(ctx context.Context, in <-chan *pb.Match, out chan<- string) error {
 	var proposals = []*pb.Match{}
-	for {
-		req, err := stream.Recv()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return err
-		}
-		proposals = append(proposals, req.GetMatch())
+	for m := range in {
+		proposals = append(proposals, m)
 	}
 
 	logger.WithFields(logrus.Fields{
@@ -91,8 +82,10 @@ func (s *EvaluatorService) Evaluate(stream pb.Evaluator_EvaluateServer) error {
 	}
 
 	for _, result := range results {
-		if err := stream.Send(&pb.EvaluateResponse{MatchId: result}); err != nil {
-			return err
+		select {
+		case out <- result:
+		case <-ctx.Done():
+			return ctx.Err()
 		}
 	}
 